@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"io"
 	"os"
 	"os/signal"
 	"syscall"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
+	"gopkg.in/yaml.v3"
 
 	"github.com/pipego/runner/config"
 	"github.com/pipego/runner/server"
@@ -21,9 +23,10 @@ const (
 )
 
 var (
-	app       = kingpin.New("runner", "pipego runner").Version(config.Version + "-build-" + config.Build)
-	listenUrl = app.Flag("listen-url", "Listen URL (host:port)").Required().String()
-	logLevel  = app.Flag("log-level", "Log level (DEBUG|INFO|WARN|ERROR)").Default("INFO").String()
+	app        = kingpin.New("runner", "pipego runner").Version(config.Version + "-build-" + config.Build)
+	configFile = app.Flag("config-file", "Config file (.yml)").String()
+	listenUrl  = app.Flag("listen-url", "Listen URL (host:port)").Required().String()
+	logLevel   = app.Flag("log-level", "Log level (DEBUG|INFO|WARN|ERROR)").Default("INFO").String()
 )
 
 func Run(ctx context.Context) error {
@@ -34,7 +37,7 @@ func Run(ctx context.Context) error {
 		return errors.Wrap(err, "failed to init logger")
 	}
 
-	c, err := initConfig(ctx, logger)
+	c, err := initConfig(ctx, logger, *configFile)
 	if err != nil {
 		return errors.Wrap(err, "failed to init config")
 	}
@@ -58,18 +61,39 @@ func initLogger(_ context.Context, level string) (hclog.Logger, error) {
 	}), nil
 }
 
-func initConfig(_ context.Context, _ hclog.Logger) (*config.Config, error) {
+func initConfig(_ context.Context, _ hclog.Logger, name string) (*config.Config, error) {
 	c := config.New()
+
+	if name == "" {
+		return c, nil
+	}
+
+	fi, err := os.Open(name)
+	if err != nil {
+		return c, errors.Wrap(err, "failed to open")
+	}
+
+	defer func() {
+		_ = fi.Close()
+	}()
+
+	buf, _ := io.ReadAll(fi)
+
+	if err := yaml.Unmarshal(buf, c); err != nil {
+		return c, errors.Wrap(err, "failed to unmarshal")
+	}
+
 	return c, nil
 }
 
-func initServer(ctx context.Context, logger hclog.Logger, _ *config.Config) (server.Server, error) {
+func initServer(ctx context.Context, logger hclog.Logger, cfg *config.Config) (server.Server, error) {
 	c := server.DefaultConfig()
 	if c == nil {
 		return nil, errors.New("failed to config")
 	}
 
 	c.Addr = *listenUrl
+	c.Config = *cfg
 	c.Logger = logger
 
 	return server.New(ctx, c), nil
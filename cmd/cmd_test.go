@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -16,14 +17,27 @@ func TestInitLogger(t *testing.T) {
 }
 
 func TestInitConfig(t *testing.T) {
-	var err error
+	logger, _ := initLogger(context.Background(), "WARN")
+
+	c, err := initConfig(context.Background(), logger, "")
 	assert.Equal(t, nil, err)
+	assert.NotEqual(t, nil, c)
+
+	c, err = initConfig(context.Background(), logger, "../test/config.yml")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 10*time.Second, c.Spec.Task.Grace)
+	assert.Equal(t, "docker.io", c.Spec.Registry.Credentials[0].Host)
+	assert.Equal(t, "allowlist", c.Spec.Task.Env.Policy)
+	assert.Equal(t, "TZ", c.Spec.Task.Env.Defaults[0].Name)
+
+	_, err = initConfig(context.Background(), logger, "../test/invalid.yml")
+	assert.NotEqual(t, nil, err)
 }
 
 func TestInitServer(t *testing.T) {
 	logger, _ := initLogger(context.Background(), "WARN")
 
-	c, err := initConfig(context.Background(), logger)
+	c, err := initConfig(context.Background(), logger, "")
 	assert.Equal(t, nil, err)
 
 	_, err = initServer(context.Background(), logger, c)
@@ -1,5 +1,9 @@
 package config
 
+import (
+	"time"
+)
+
 type Config struct {
 	ApiVersion string   `yaml:"apiVersion"`
 	Kind       string   `yaml:"kind"`
@@ -12,6 +16,82 @@ type MetaData struct {
 }
 
 type Spec struct {
+	Task      Task      `yaml:"task"`
+	Registry  Registry  `yaml:"registry"`
+	Cache     Cache     `yaml:"cache"`
+	Queue     Queue     `yaml:"queue"`
+	Log       Log       `yaml:"log"`
+	Workspace Workspace `yaml:"workspace"`
+}
+
+type Task struct {
+	Grace  time.Duration `yaml:"grace"`
+	Cgroup Cgroup        `yaml:"cgroup"`
+	Env    Env           `yaml:"env"`
+}
+
+type Env struct {
+	Policy    string     `yaml:"policy"`
+	Allowlist []string   `yaml:"allowlist"`
+	Defaults  []Variable `yaml:"defaults"`
+}
+
+type Variable struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+type Cgroup struct {
+	Root   string `yaml:"root"`
+	Parent string `yaml:"parent"`
+}
+
+type Registry struct {
+	Config      string       `yaml:"config"`
+	Credentials []Credential `yaml:"credentials"`
+}
+
+type Credential struct {
+	Name string `yaml:"name"`
+	Host string `yaml:"host"`
+	User string `yaml:"user"`
+	Pass string `yaml:"pass"`
+}
+
+type Cache struct {
+	MaxSize int64 `yaml:"maxSize"`
+}
+
+type Queue struct {
+	MaxTasks   int64      `yaml:"maxTasks"`
+	MaxSize    int64      `yaml:"maxSize"`
+	Overcommit Overcommit `yaml:"overcommit"`
+}
+
+type Overcommit struct {
+	MilliCPU float64 `yaml:"milliCPU"`
+	Memory   float64 `yaml:"memory"`
+	Storage  float64 `yaml:"storage"`
+}
+
+type Log struct {
+	Root      string        `yaml:"root"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+type Workspace struct {
+	Root      string        `yaml:"root"`
+	Retention time.Duration `yaml:"retention"`
+}
+
+// String returns credential without user and pass, which keeps them out of logs
+func (c Credential) String() string {
+	return c.Name + "@" + c.Host
+}
+
+// GoString returns credential in the same form of String
+func (c Credential) GoString() string {
+	return c.String()
 }
 
 var (
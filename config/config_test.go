@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,3 +11,16 @@ func TestConfig(t *testing.T) {
 	cfg := New()
 	assert.NotEqual(t, nil, cfg)
 }
+
+func TestCredential(t *testing.T) {
+	c := Credential{
+		Name: "name",
+		Host: "docker.io",
+		User: "user",
+		Pass: "pass",
+	}
+
+	assert.Equal(t, "name@docker.io", fmt.Sprintf("%v", c))
+	assert.Equal(t, "name@docker.io", fmt.Sprintf("%+v", c))
+	assert.Equal(t, "name@docker.io", fmt.Sprintf("%#v", c))
+}
@@ -1,12 +1,18 @@
 package file
 
 import (
+	"archive/tar"
 	"bufio"
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
@@ -34,7 +40,10 @@ var (
 type File interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
+	Zip(context.Context, []byte) ([]byte, error)
 	Unzip(context.Context, []byte) ([]byte, error)
+	Tar(context.Context, string, []string, int64) ([]byte, []Checksum, error)
+	Untar(context.Context, string, []byte, int64) error
 	Write(context.Context, string, []byte) error
 	Remove(context.Context, string) error
 	Type(context.Context, string) int
@@ -45,6 +54,12 @@ type Config struct {
 	Logger hclog.Logger
 }
 
+type Checksum struct {
+	Name   string
+	Size   int64
+	Sha256 string
+}
+
 type file struct {
 	cfg *Config
 }
@@ -67,6 +82,22 @@ func (f *file) Deinit(_ context.Context) error {
 	return nil
 }
 
+func (f *file) Zip(_ context.Context, data []byte) ([]byte, error) {
+	var b bytes.Buffer
+
+	w := gzip.NewWriter(&b)
+
+	if _, err := w.Write(data); err != nil {
+		return nil, errors.Wrap(err, "failed to write")
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close")
+	}
+
+	return b.Bytes(), nil
+}
+
 func (f *file) Unzip(_ context.Context, data []byte) ([]byte, error) {
 	r, err := gzip.NewReader(bytes.NewReader(data))
 	if err != nil {
@@ -85,6 +116,223 @@ func (f *file) Unzip(_ context.Context, data []byte) ([]byte, error) {
 	return buf, nil
 }
 
+// Tar archives the regular files in dir matched by the patterns relative to dir, up to maxSize bytes in total.
+// The files resolved out of dir in symlinks are skipped.
+func (f *file) Tar(_ context.Context, dir string, patterns []string, maxSize int64) ([]byte, []Checksum, error) {
+	var b bytes.Buffer
+	var checksums []Checksum
+	var size int64
+
+	root, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to resolve")
+	}
+
+	names, err := f.match(root, patterns)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to match")
+	}
+
+	w := tar.NewWriter(&b)
+
+	for _, name := range names {
+		file, info, err := f.open(root, name)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to open")
+		}
+		size += info.Size()
+		if maxSize > 0 && size > maxSize {
+			_ = file.Close()
+			return nil, nil, errors.New("exceed max size")
+		}
+		checksum, err := f.archive(w, name, file, info)
+		_ = file.Close()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to archive")
+		}
+		checksums = append(checksums, checksum)
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to close")
+	}
+
+	return b.Bytes(), checksums, nil
+}
+
+// match returns the sorted names of regular files relative to root, and matched directories are walked through.
+// Glob follows symlinked directories, so each file is resolved in symlinks and skipped if it is out of root.
+func (f *file) match(root string, patterns []string) ([]string, error) {
+	found := map[string]bool{}
+
+	for _, pattern := range patterns {
+		pattern = filepath.Clean(pattern)
+		if filepath.IsAbs(pattern) || pattern == ".." || strings.HasPrefix(pattern, ".."+string(os.PathSeparator)) {
+			return nil, errors.New("invalid pattern")
+		}
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid pattern")
+		}
+		for _, item := range matches {
+			if !f.within(root, item) {
+				continue
+			}
+			_ = filepath.WalkDir(item, func(path string, d fs.DirEntry, err error) error {
+				if err == nil && d.Type().IsRegular() && f.within(root, path) {
+					name, _ := filepath.Rel(root, path)
+					found[name] = true
+				}
+				return nil
+			})
+		}
+	}
+
+	names := make([]string, 0, len(found))
+	for name := range found {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// within reports whether path is resolved in symlinks to root or the files in root
+func (f *file) within(root, path string) bool {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(root, resolved)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+		return false
+	}
+
+	return true
+}
+
+// open opens the regular file in name relative to root without following symlinks, and it is checked again
+// in case it is replaced after matched
+func (f *file) open(root, name string) (*os.File, os.FileInfo, error) {
+	path := filepath.Join(root, name)
+
+	if info, err := os.Lstat(path); err != nil || !info.Mode().IsRegular() || !f.within(root, path) {
+		return nil, nil, errors.New("invalid file " + name)
+	}
+
+	file, err := os.OpenFile(path, openFlag, 0)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open")
+	}
+
+	info, err := file.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		_ = file.Close()
+		return nil, nil, errors.New("invalid file " + name)
+	}
+
+	return file, info, nil
+}
+
+func (f *file) archive(w *tar.Writer, name string, file *os.File, info os.FileInfo) (Checksum, error) {
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return Checksum{}, errors.Wrap(err, "failed to header")
+	}
+
+	header.Name = filepath.ToSlash(name)
+
+	if err := w.WriteHeader(header); err != nil {
+		return Checksum{}, errors.Wrap(err, "failed to write header")
+	}
+
+	h := sha256.New()
+
+	n, err := io.Copy(io.MultiWriter(w, h), io.LimitReader(file, info.Size()))
+	if err != nil {
+		return Checksum{}, errors.Wrap(err, "failed to copy")
+	}
+
+	return Checksum{
+		Name:   header.Name,
+		Size:   n,
+		Sha256: hex.EncodeToString(h.Sum(nil)),
+	}, nil
+}
+
+// Untar extracts the tar.gz data into dir, up to maxSize bytes in total. Only directories and regular files
+// are supported, and the names escaping from dir are rejected.
+func (f *file) Untar(_ context.Context, dir string, data []byte, maxSize int64) error {
+	var size int64
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return errors.Wrap(err, "failed to new")
+	}
+
+	defer func(gz *gzip.Reader) {
+		_ = gz.Close()
+	}(gz)
+
+	r := tar.NewReader(gz)
+
+	for {
+		header, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to read")
+		}
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || filepath.VolumeName(name) != "" || name == ".." ||
+			strings.HasPrefix(name, ".."+string(os.PathSeparator)) {
+			return errors.New("invalid name " + header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(filepath.Join(dir, name), Perm); err != nil {
+				return errors.Wrap(err, "failed to mkdir")
+			}
+		case tar.TypeReg:
+			size += header.Size
+			if maxSize > 0 && size > maxSize {
+				return errors.New("exceed max size")
+			}
+			if err := f.extract(r, filepath.Join(dir, name), header.FileInfo().Mode().Perm()); err != nil {
+				return errors.Wrap(err, "failed to extract")
+			}
+		default:
+			return errors.New("invalid type " + header.Name)
+		}
+	}
+
+	return nil
+}
+
+func (f *file) extract(r io.Reader, name string, perm os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(name), Perm); err != nil {
+		return errors.Wrap(err, "failed to mkdir")
+	}
+
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return errors.Wrap(err, "failed to open")
+	}
+
+	defer func(file *os.File) {
+		_ = file.Close()
+	}(file)
+
+	if _, err := io.Copy(file, r); err != nil {
+		return errors.Wrap(err, "failed to copy")
+	}
+
+	return nil
+}
+
 func (f *file) Write(_ context.Context, name string, data []byte) error {
 	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, Perm)
 	if err != nil {
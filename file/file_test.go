@@ -1,10 +1,13 @@
 package file
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
+	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -116,3 +119,173 @@ func TestType(t *testing.T) {
 	assert.Equal(t, Invalid, ret)
 	_ = f.Remove(ctx, FileTest)
 }
+
+func TestZip(t *testing.T) {
+	f := file{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	buf := []byte("#!/bin/bash\necho \"Hello World!\"")
+	ret, err := f.Zip(ctx, buf)
+	assert.Equal(t, nil, err)
+
+	ret, err = f.Unzip(ctx, ret)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, buf, ret)
+}
+
+func TestTar(t *testing.T) {
+	f := file{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	_ = os.MkdirAll(filepath.Join(dir, "out", "sub"), 0755)
+	_ = os.WriteFile(filepath.Join(dir, "out", "a.txt"), []byte("a"), 0644)
+	_ = os.WriteFile(filepath.Join(dir, "out", "sub", "b.txt"), []byte("bb"), 0644)
+	_ = os.WriteFile(filepath.Join(dir, "c.log"), []byte("ccc"), 0644)
+
+	buf, checksums, err := f.Tar(ctx, dir, []string{"out", "*.log", "*.none"}, 1024)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(checksums))
+	assert.Equal(t, "c.log", checksums[0].Name)
+	assert.Equal(t, int64(3), checksums[0].Size)
+	assert.Equal(t, "out/a.txt", checksums[1].Name)
+	assert.Equal(t, "ca978112ca1bbdcafac231b39a23dc4da786eff8147c4e72b9807785afee48bb", checksums[1].Sha256)
+	assert.Equal(t, "out/sub/b.txt", checksums[2].Name)
+
+	r := tar.NewReader(bytes.NewReader(buf))
+
+	for _, item := range checksums {
+		h, err := r.Next()
+		assert.Equal(t, nil, err)
+		assert.Equal(t, item.Name, h.Name)
+		assert.Equal(t, item.Size, h.Size)
+	}
+
+	_, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+
+	_, _, err = f.Tar(ctx, dir, []string{"out"}, 2)
+	assert.NotEqual(t, nil, err)
+
+	_, _, err = f.Tar(ctx, dir, []string{"../*"}, 1024)
+	assert.NotEqual(t, nil, err)
+
+	_, _, err = f.Tar(ctx, dir, []string{"/etc/*"}, 1024)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestTarSymlink(t *testing.T) {
+	f := file{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	host := t.TempDir()
+
+	_ = os.WriteFile(filepath.Join(host, "secret.txt"), []byte("secret"), 0644)
+	_ = os.MkdirAll(filepath.Join(dir, "out", "sub"), 0755)
+	_ = os.WriteFile(filepath.Join(dir, "out", "sub", "a.txt"), []byte("a"), 0644)
+
+	if err := os.Symlink(host, filepath.Join(dir, "host")); err != nil {
+		t.Skip("symlink not supported")
+	}
+
+	_ = os.Symlink(filepath.Join(host, "secret.txt"), filepath.Join(dir, "out", "secret.txt"))
+	_ = os.Symlink(filepath.Join(dir, "out", "sub"), filepath.Join(dir, "link"))
+
+	_, checksums, err := f.Tar(ctx, dir, []string{"host/*", "host", "out", "link/*"}, 1024)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(checksums))
+	assert.Equal(t, "link/a.txt", checksums[0].Name)
+	assert.Equal(t, "out/sub/a.txt", checksums[1].Name)
+
+	_, _, err = f.open(dir, filepath.Join("host", "secret.txt"))
+	assert.NotEqual(t, nil, err)
+
+	_, _, err = f.open(dir, filepath.Join("out", "secret.txt"))
+	assert.NotEqual(t, nil, err)
+}
+
+func initTar(headers []*tar.Header, data []byte) ([]byte, error) {
+	var b bytes.Buffer
+
+	gz := gzip.NewWriter(&b)
+	w := tar.NewWriter(gz)
+
+	for _, item := range headers {
+		if item.Typeflag == tar.TypeReg {
+			item.Size = int64(len(data))
+		}
+		if err := w.WriteHeader(item); err != nil {
+			return nil, err
+		}
+		if item.Typeflag == tar.TypeReg {
+			if _, err := w.Write(data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func TestUntar(t *testing.T) {
+	f := file{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	buf, err := initTar([]*tar.Header{
+		{Name: "src/", Typeflag: tar.TypeDir, Mode: 0755},
+		{Name: "src/main.py", Typeflag: tar.TypeReg, Mode: 0755},
+		{Name: "data/fixture.txt", Typeflag: tar.TypeReg, Mode: 0644},
+	}, []byte("data"))
+	assert.Equal(t, nil, err)
+
+	err = f.Untar(ctx, dir, buf, 1024)
+	assert.Equal(t, nil, err)
+
+	ret, err := os.ReadFile(filepath.Join(dir, "data", "fixture.txt"))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []byte("data"), ret)
+
+	info, err := os.Stat(filepath.Join(dir, "src", "main.py"))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+	err = f.Untar(ctx, t.TempDir(), buf, 4)
+	assert.NotEqual(t, nil, err)
+
+	err = f.Untar(ctx, t.TempDir(), []byte("data"), 1024)
+	assert.NotEqual(t, nil, err)
+
+	for _, item := range []*tar.Header{
+		{Name: "../evil.txt", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "src/../../evil.txt", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "/tmp/evil.txt", Typeflag: tar.TypeReg, Mode: 0644},
+		{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc"},
+		{Name: "hard", Typeflag: tar.TypeLink, Linkname: "/etc/passwd"},
+	} {
+		buf, err = initTar([]*tar.Header{item}, []byte("evil"))
+		assert.Equal(t, nil, err)
+		err = f.Untar(ctx, filepath.Join(t.TempDir(), "workspace"), buf, 1024)
+		assert.NotEqual(t, nil, err)
+	}
+}
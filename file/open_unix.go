@@ -0,0 +1,11 @@
+//go:build linux || darwin
+
+package file
+
+import (
+	"os"
+	"syscall"
+)
+
+// openFlag opens file without following symlink in the last element
+const openFlag = os.O_RDONLY | syscall.O_NOFOLLOW
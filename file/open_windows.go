@@ -0,0 +1,10 @@
+//go:build windows
+
+package file
+
+import (
+	"os"
+)
+
+// openFlag opens file in read only, and symlink is rejected in Lstat since O_NOFOLLOW is not supported
+const openFlag = os.O_RDONLY
@@ -49,6 +49,7 @@ type Glance interface {
 	Dir(context.Context, string) ([]Entry, error)
 	File(context.Context, string, int64) (string, bool, error)
 	Sys(context.Context) (Resource, Resource, Stats, Stats, Stats, []Process, string, string, error)
+	Allocatable(context.Context) (Resource, error)
 }
 
 type Config struct {
@@ -179,6 +180,20 @@ func (g *glance) Sys(_ context.Context) (allocatable, requested Resource, _cpu,
 	return allocatable, requested, _cpu, _memory, _storage, _processes, _host, _os, nil
 }
 
+// Allocatable returns the allocatable resource of host, which is taken without sampling the usage of cpu
+func (g *glance) Allocatable(_ context.Context) (allocatable Resource, err error) {
+	c, err := cpu.Counts(true)
+	if err != nil {
+		return allocatable, errors.Wrap(err, "failed to count cpu")
+	}
+
+	allocatable.MilliCPU = int64(c * Milli)
+	allocatable.Memory, _ = g.memory()
+	allocatable.Storage, _ = g.storage()
+
+	return allocatable, nil
+}
+
 func (g *glance) entry(dname, fname string) (Entry, error) {
 	var uname, gname string
 
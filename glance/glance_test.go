@@ -69,6 +69,18 @@ func TestSys(t *testing.T) {
 	assert.NotEqual(t, "", _os)
 }
 
+func TestAllocatable(t *testing.T) {
+	g := glance{
+		cfg: DefaultConfig(),
+	}
+
+	allocatable, err := g.Allocatable(context.Background())
+	assert.Equal(t, nil, err)
+	assert.Less(t, int64(0), allocatable.MilliCPU)
+	assert.NotEqual(t, int64(-1), allocatable.Memory)
+	assert.NotEqual(t, int64(-1), allocatable.Storage)
+}
+
 func TestEntry(t *testing.T) {
 	g := glance{
 		cfg: DefaultConfig(),
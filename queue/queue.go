@@ -0,0 +1,255 @@
+package queue
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/pipego/runner/config"
+	"github.com/pipego/runner/glance"
+)
+
+var (
+	ErrExceeded = errors.New("resources exceeded")
+	ErrFull     = errors.New("queue full")
+)
+
+type Queue interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Acquire(context.Context, int64, glance.Resource, func(int64)) error
+	Release(context.Context, glance.Resource)
+	Reserved(context.Context) glance.Resource
+}
+
+// Config has the allocatable resource of host, and the resource not positive is not limited
+type Config struct {
+	Config      config.Config
+	Logger      hclog.Logger
+	Allocatable glance.Resource
+}
+
+type queue struct {
+	cfg      *Config
+	mu       sync.Mutex
+	seq      uint64
+	running  int64
+	reserved glance.Resource
+	waiting  []*waiter
+}
+
+// waiter is the task waiting in queue, which is ready as the slot is granted
+// and updated as its position is changed
+type waiter struct {
+	priority int64
+	request  glance.Resource
+	seq      uint64
+	granted  bool
+	ready    chan struct{}
+	update   chan struct{}
+}
+
+func New(_ context.Context, cfg *Config) Queue {
+	return &queue{
+		cfg: cfg,
+	}
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+func (q *queue) Init(_ context.Context) error {
+	return nil
+}
+
+func (q *queue) Deinit(_ context.Context) error {
+	return nil
+}
+
+// Acquire takes a slot of running tasks and reserves the requested resource, or waits in queue in order
+// of priority (higher first) and arrival until the slot and resource are released. The position in queue
+// (1 for the next) is reported with the callback as it is changed. ErrExceeded is returned if the request
+// exceeds the capacity of host, and ErrFull is returned if the queue is full.
+func (q *queue) Acquire(ctx context.Context, priority int64, request glance.Resource, position func(int64)) error {
+	q.mu.Lock()
+
+	if !q.fit(glance.Resource{}, request) {
+		q.mu.Unlock()
+		return ErrExceeded
+	}
+
+	if q.available(request) && len(q.waiting) == 0 {
+		q.reserve(request)
+		q.mu.Unlock()
+		return nil
+	}
+
+	if size := q.cfg.Config.Spec.Queue.MaxSize; size > 0 && int64(len(q.waiting)) >= size {
+		q.mu.Unlock()
+		return ErrFull
+	}
+
+	q.seq++
+
+	w := &waiter{
+		priority: priority,
+		request:  request,
+		seq:      q.seq,
+		ready:    make(chan struct{}),
+		update:   make(chan struct{}, 1),
+	}
+
+	q.waiting = append(q.waiting, w)
+
+	sort.SliceStable(q.waiting, func(i, j int) bool {
+		if q.waiting[i].priority != q.waiting[j].priority {
+			return q.waiting[i].priority > q.waiting[j].priority
+		}
+		return q.waiting[i].seq < q.waiting[j].seq
+	})
+
+	// Waiter inserted at the head is granted if it fits
+	q.grant()
+	q.notify()
+	q.mu.Unlock()
+
+	var pos int64
+
+	for {
+		select {
+		case <-w.ready:
+			return nil
+		case <-w.update:
+			if p := q.position(w); p > 0 && p != pos {
+				pos = p
+				position(pos)
+			}
+		case <-ctx.Done():
+			q.cancel(ctx, w)
+			return errors.Wrap(ctx.Err(), "failed to wait")
+		}
+	}
+}
+
+// Release returns the slot and resource of running task, which are granted to the head of queue
+func (q *queue) Release(_ context.Context, request glance.Resource) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.running > 0 {
+		q.running--
+	}
+
+	q.reserved.MilliCPU -= request.MilliCPU
+	q.reserved.Memory -= request.Memory
+	q.reserved.Storage -= request.Storage
+
+	q.grant()
+	q.notify()
+}
+
+// Reserved returns the sum of requests of running tasks
+func (q *queue) Reserved(_ context.Context) glance.Resource {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.reserved
+}
+
+// cancel removes waiter from queue, and the slot is released if it has been granted meanwhile
+func (q *queue) cancel(ctx context.Context, w *waiter) {
+	q.mu.Lock()
+
+	if w.granted {
+		q.mu.Unlock()
+		q.Release(ctx, w.request)
+		return
+	}
+
+	for i := range q.waiting {
+		if q.waiting[i] == w {
+			q.waiting = append(q.waiting[:i], q.waiting[i+1:]...)
+			break
+		}
+	}
+
+	// Waiter behind the cancelled head is granted if it fits
+	q.grant()
+	q.notify()
+	q.mu.Unlock()
+}
+
+// grant grants the slot and resource to the head of queue as long as it fits, and the tasks behind
+// never overtake the head
+func (q *queue) grant() {
+	for len(q.waiting) != 0 && q.available(q.waiting[0].request) {
+		w := q.waiting[0]
+		q.waiting = q.waiting[1:]
+		q.reserve(w.request)
+		w.granted = true
+		close(w.ready)
+	}
+}
+
+func (q *queue) position(w *waiter) int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i := range q.waiting {
+		if q.waiting[i] == w {
+			return int64(i + 1)
+		}
+	}
+
+	return 0
+}
+
+func (q *queue) available(request glance.Resource) bool {
+	limit := q.cfg.Config.Spec.Queue.MaxTasks
+
+	if limit > 0 && q.running >= limit {
+		return false
+	}
+
+	return q.fit(q.reserved, request)
+}
+
+func (q *queue) reserve(request glance.Resource) {
+	q.running++
+	q.reserved.MilliCPU += request.MilliCPU
+	q.reserved.Memory += request.Memory
+	q.reserved.Storage += request.Storage
+}
+
+// fit checks if request fits in the capacity of host in overcommit ratio (default: 1.0) with reserved
+func (q *queue) fit(reserved, request glance.Resource) bool {
+	helper := func(allocatable int64, ratio float64, reserved, request int64) bool {
+		if allocatable <= 0 || request <= 0 {
+			return true
+		}
+		if ratio <= 0 {
+			ratio = 1
+		}
+		return float64(reserved+request) <= float64(allocatable)*ratio
+	}
+
+	allocatable := q.cfg.Allocatable
+	overcommit := q.cfg.Config.Spec.Queue.Overcommit
+
+	return helper(allocatable.MilliCPU, overcommit.MilliCPU, reserved.MilliCPU, request.MilliCPU) &&
+		helper(allocatable.Memory, overcommit.Memory, reserved.Memory, request.Memory) &&
+		helper(allocatable.Storage, overcommit.Storage, reserved.Storage, request.Storage)
+}
+
+func (q *queue) notify() {
+	for _, item := range q.waiting {
+		select {
+		case item.update <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,243 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipego/runner/glance"
+)
+
+func initQueue(tasks, size int64) *queue {
+	q := queue{
+		cfg: DefaultConfig(),
+	}
+
+	q.cfg.Config.Spec.Queue.MaxTasks = tasks
+	q.cfg.Config.Spec.Queue.MaxSize = size
+	q.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "queue",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	return &q
+}
+
+func waitQueue(q *queue, size int) {
+	for {
+		q.mu.Lock()
+		n := len(q.waiting)
+		q.mu.Unlock()
+		if n == size {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestUnlimited(t *testing.T) {
+	q := initQueue(0, 0)
+	ctx := context.Background()
+
+	for i := 0; i < 10; i++ {
+		err := q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+		assert.Equal(t, nil, err)
+	}
+
+	assert.Equal(t, int64(10), q.running)
+
+	q.Release(ctx, glance.Resource{})
+	assert.Equal(t, int64(9), q.running)
+}
+
+func TestFull(t *testing.T) {
+	q := initQueue(1, 1)
+	ctx := context.Background()
+
+	err := q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	assert.Equal(t, nil, err)
+
+	done := make(chan error)
+
+	go func() {
+		done <- q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	}()
+
+	waitQueue(q, 1)
+
+	err = q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	assert.Equal(t, ErrFull, err)
+
+	q.Release(ctx, glance.Resource{})
+	assert.Equal(t, nil, <-done)
+	assert.Equal(t, int64(1), q.running)
+}
+
+func TestPriority(t *testing.T) {
+	q := initQueue(1, 0)
+	ctx := context.Background()
+
+	err := q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	assert.Equal(t, nil, err)
+
+	var mu sync.Mutex
+	var order []string
+
+	positions := map[string]int64{}
+	wg := sync.WaitGroup{}
+
+	wait := func(name string, pos int64) {
+		for {
+			mu.Lock()
+			p := positions[name]
+			mu.Unlock()
+			if p == pos {
+				return
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	acquire := func(name string, priority int64) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = q.Acquire(ctx, priority, glance.Resource{}, func(pos int64) {
+				mu.Lock()
+				positions[name] = pos
+				mu.Unlock()
+			})
+			mu.Lock()
+			order = append(order, name)
+			mu.Unlock()
+			q.Release(ctx, glance.Resource{})
+		}()
+	}
+
+	acquire("low", 0)
+	wait("low", 1)
+
+	acquire("high", 10)
+	wait("high", 1)
+	wait("low", 2)
+
+	acquire("low2", 0)
+	wait("low2", 3)
+
+	q.Release(ctx, glance.Resource{})
+	wg.Wait()
+
+	assert.Equal(t, []string{"high", "low", "low2"}, order)
+	assert.Equal(t, int64(0), q.running)
+}
+
+func TestCancel(t *testing.T) {
+	q := initQueue(1, 0)
+	ctx := context.Background()
+
+	err := q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	assert.Equal(t, nil, err)
+
+	_ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+
+	go func() {
+		done <- q.Acquire(_ctx, 0, glance.Resource{}, func(int64) {})
+	}()
+
+	waitQueue(q, 1)
+	cancel()
+
+	assert.NotEqual(t, nil, <-done)
+	assert.Equal(t, 0, len(q.waiting))
+
+	q.Release(ctx, glance.Resource{})
+	assert.Equal(t, int64(0), q.running)
+}
+
+func TestResource(t *testing.T) {
+	q := initQueue(0, 0)
+	q.cfg.Allocatable = glance.Resource{MilliCPU: 2000, Memory: 1024, Storage: -1}
+	q.cfg.Config.Spec.Queue.Overcommit.MilliCPU = 2
+
+	ctx := context.Background()
+
+	err := q.Acquire(ctx, 0, glance.Resource{MilliCPU: 5000}, func(int64) {})
+	assert.Equal(t, ErrExceeded, err)
+
+	err = q.Acquire(ctx, 0, glance.Resource{Memory: 2048}, func(int64) {})
+	assert.Equal(t, ErrExceeded, err)
+
+	err = q.Acquire(ctx, 0, glance.Resource{MilliCPU: 3000, Memory: 512, Storage: 1024}, func(int64) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, glance.Resource{MilliCPU: 3000, Memory: 512, Storage: 1024}, q.Reserved(ctx))
+
+	// Tasks without requests are not limited by resource
+	err = q.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	assert.Equal(t, nil, err)
+
+	done := make(chan error)
+
+	go func() {
+		done <- q.Acquire(ctx, 0, glance.Resource{MilliCPU: 2000}, func(int64) {})
+	}()
+
+	waitQueue(q, 1)
+
+	// Head of queue is never overtaken
+	go func() {
+		done <- q.Acquire(ctx, 0, glance.Resource{Memory: 128}, func(int64) {})
+	}()
+
+	waitQueue(q, 2)
+
+	q.Release(ctx, glance.Resource{MilliCPU: 3000, Memory: 512, Storage: 1024})
+	assert.Equal(t, nil, <-done)
+	assert.Equal(t, nil, <-done)
+	assert.Equal(t, glance.Resource{MilliCPU: 2000, Memory: 128}, q.Reserved(ctx))
+	assert.Equal(t, int64(3), q.running)
+}
+
+func TestGrant(t *testing.T) {
+	q := initQueue(0, 0)
+	q.cfg.Allocatable = glance.Resource{MilliCPU: 2000}
+
+	ctx := context.Background()
+
+	err := q.Acquire(ctx, 0, glance.Resource{MilliCPU: 1500}, func(int64) {})
+	assert.Equal(t, nil, err)
+
+	_ctx, cancel := context.WithCancel(ctx)
+	head := make(chan error)
+	done := make(chan error)
+
+	go func() {
+		head <- q.Acquire(_ctx, 0, glance.Resource{MilliCPU: 2000}, func(int64) {})
+	}()
+
+	waitQueue(q, 1)
+
+	// Higher priority inserted at the head is granted if it fits
+	err = q.Acquire(ctx, 1, glance.Resource{MilliCPU: 300}, func(int64) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, glance.Resource{MilliCPU: 1800}, q.Reserved(ctx))
+
+	go func() {
+		done <- q.Acquire(ctx, 0, glance.Resource{MilliCPU: 200}, func(int64) {})
+	}()
+
+	waitQueue(q, 2)
+
+	// Waiter behind the cancelled head is granted if it fits
+	cancel()
+
+	assert.NotEqual(t, nil, <-head)
+	assert.Equal(t, nil, <-done)
+	assert.Equal(t, 0, len(q.waiting))
+	assert.Equal(t, glance.Resource{MilliCPU: 2000}, q.Reserved(ctx))
+	assert.Equal(t, int64(3), q.running)
+}
@@ -0,0 +1,233 @@
+package server
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	pb "github.com/pipego/runner/server/proto"
+)
+
+const (
+	EnvRunnerId = "PIPEGO_RUNNER_ID"
+	EnvTaskId   = "PIPEGO_TASK_ID"
+	EnvTaskName = "PIPEGO_TASK_NAME"
+)
+
+const (
+	PolicyAllowlist = "allowlist"
+	PolicyClean     = "clean"
+	PolicyInherit   = "inherit"
+)
+
+const (
+	envDefault = ":-"
+	envEscape  = '$'
+)
+
+// filterEnv returns the variables in environ selected in policy, and allowlist is matched in glob patterns of names
+func filterEnv(environ []string, policy string, allowlist []string) ([]string, error) {
+	buf := []string{}
+
+	switch policy {
+	case "", PolicyInherit:
+		return append(buf, environ...), nil
+	case PolicyClean:
+		return buf, nil
+	case PolicyAllowlist:
+	default:
+		return nil, errors.New("invalid policy: " + policy)
+	}
+
+	for _, pattern := range allowlist {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.New("invalid allowlist: " + pattern)
+		}
+	}
+
+	for _, item := range environ {
+		name, _, _ := strings.Cut(item, "=")
+		for _, pattern := range allowlist {
+			if ok, _ := path.Match(pattern, name); ok {
+				buf = append(buf, item)
+				break
+			}
+		}
+	}
+
+	return buf, nil
+}
+
+// expander expands the references in $NAME, ${NAME} and ${NAME:-default} of params, and $$ is escaped in $.
+// The references are resolved in params and then in vars of runner, and the undefined are expanded in empty.
+// The reference to param in expanding is resolved in vars (e.g. PATH=$PATH:/bin), or reported as cyclic.
+type expander struct {
+	params map[string]string
+	vars   map[string]string
+	values map[string]string
+	stack  []string
+}
+
+func newExpander(params []*pb.TaskParam, vars map[string]string) *expander {
+	e := &expander{
+		params: map[string]string{},
+		vars:   vars,
+		values: map[string]string{},
+	}
+
+	for _, item := range params {
+		e.params[item.GetName()] = item.GetValue()
+	}
+
+	return e
+}
+
+// expand returns data of param in name expanded, and the cyclic references are reported in error
+func (e *expander) expand(name, data string) (string, error) {
+	e.stack = append(e.stack[:0], name)
+	defer func() {
+		e.stack = e.stack[:0]
+	}()
+
+	return e.eval(data)
+}
+
+func (e *expander) eval(data string) (string, error) {
+	var b strings.Builder
+
+	for i := 0; i < len(data); {
+		if data[i] != envEscape || i+1 == len(data) {
+			b.WriteByte(data[i])
+			i++
+			continue
+		}
+
+		switch c := data[i+1]; {
+		case c == envEscape:
+			b.WriteByte(envEscape)
+			i += 2
+		case c == '{':
+			end := e.match(data, i+1)
+			if end < 0 {
+				return "", errors.New("unterminated reference")
+			}
+			val, err := e.evalBrace(data[i+2 : end])
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(val)
+			i = end + 1
+		case e.isNameStart(c):
+			j := i + 2
+			for j < len(data) && e.isName(data[j]) {
+				j++
+			}
+			val, _, err := e.lookup(data[i+1 : j])
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(val)
+			i = j
+		default:
+			b.WriteByte(data[i])
+			i++
+		}
+	}
+
+	return b.String(), nil
+}
+
+// evalBrace expands NAME or NAME:-default in braces, and default is expanded if NAME is undefined or empty
+func (e *expander) evalBrace(data string) (string, error) {
+	name, def, ok := strings.Cut(data, envDefault)
+
+	if !e.validName(name) {
+		return "", errors.New("invalid reference: " + name)
+	}
+
+	val, found, err := e.lookup(name)
+	if err != nil {
+		return "", err
+	}
+
+	if ok && (!found || val == "") {
+		return e.eval(def)
+	}
+
+	return val, nil
+}
+
+func (e *expander) lookup(name string) (val string, found bool, err error) {
+	if val, ok := e.values[name]; ok {
+		return val, true, nil
+	}
+
+	data, ok := e.params[name]
+	if !ok {
+		val, ok = e.vars[name]
+		return val, ok, nil
+	}
+
+	for i := range e.stack {
+		if e.stack[i] == name {
+			if val, ok := e.vars[name]; ok {
+				return val, true, nil
+			}
+			return "", false, errors.New("cyclic reference: " + strings.Join(append(e.stack[i:], name), " -> "))
+		}
+	}
+
+	e.stack = append(e.stack, name)
+	val, err = e.eval(data)
+	e.stack = e.stack[:len(e.stack)-1]
+
+	if err != nil {
+		return "", false, err
+	}
+
+	e.values[name] = val
+
+	return val, true, nil
+}
+
+// match returns the index of brace closing the one in start, or -1 if not found
+func (e *expander) match(data string, start int) int {
+	depth := 0
+
+	for i := start; i < len(data); i++ {
+		switch data[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+func (e *expander) validName(name string) bool {
+	if name == "" || !e.isNameStart(name[0]) {
+		return false
+	}
+
+	for i := 1; i < len(name); i++ {
+		if !e.isName(name[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (e *expander) isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func (e *expander) isName(c byte) bool {
+	return e.isNameStart(c) || (c >= '0' && c <= '9')
+}
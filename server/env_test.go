@@ -0,0 +1,109 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/pipego/runner/server/proto"
+)
+
+func TestFilterEnv(t *testing.T) {
+	environ := []string{"PATH=/bin", "HOME=/root", "LC_ALL=C", "LC_TIME=C", "EMPTY="}
+
+	tests := []struct {
+		name      string
+		policy    string
+		allowlist []string
+		expected  []string
+		err       bool
+	}{
+		{name: "default", policy: "", expected: environ},
+		{name: "inherit", policy: PolicyInherit, allowlist: []string{"PATH"}, expected: environ},
+		{name: "clean", policy: PolicyClean, expected: []string{}},
+		{name: "allowlist", policy: PolicyAllowlist, allowlist: []string{"PATH", "LC_*", "EMPTY"},
+			expected: []string{"PATH=/bin", "LC_ALL=C", "LC_TIME=C", "EMPTY="}},
+		{name: "allowlist empty", policy: PolicyAllowlist, expected: []string{}},
+		{name: "invalid allowlist", policy: PolicyAllowlist, allowlist: []string{"["}, err: true},
+		{name: "invalid policy", policy: "invalid", err: true},
+	}
+
+	for _, item := range tests {
+		t.Run(item.name, func(t *testing.T) {
+			buf, err := filterEnv(environ, item.policy, item.allowlist)
+			assert.Equal(t, item.err, err != nil)
+			if !item.err {
+				assert.Equal(t, item.expected, buf)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	params := []*pb.TaskParam{
+		{Name: "A", Value: "a"},
+		{Name: "B", Value: "$A-b"},
+		{Name: "EMPTY", Value: ""},
+		{Name: "CYCLE1", Value: "$CYCLE2"},
+		{Name: "CYCLE2", Value: "${CYCLE3:-x}"},
+		{Name: "CYCLE3", Value: "$CYCLE1"},
+		{Name: "SELF", Value: "$SELF"},
+		{Name: "PATH", Value: "$PATH:/opt/bin"},
+		{Name: "RUNNER", Value: "${RUNNER:-x}"},
+	}
+
+	vars := map[string]string{
+		EnvTaskId: "id",
+		"PATH":    "/bin",
+		"RUNNER":  "runner",
+	}
+
+	tests := []struct {
+		name     string
+		data     string
+		expected string
+		err      bool
+	}{
+		{name: "plain", data: "value", expected: "value"},
+		{name: "reference", data: "$A", expected: "a"},
+		{name: "braces", data: "${A}", expected: "a"},
+		{name: "embedded", data: "x${A}y$B.z", expected: "xaya-b.z"},
+		{name: "name boundary", data: "$Ab", expected: ""},
+		{name: "nested", data: "$B", expected: "a-b"},
+		{name: "runner var", data: "task-$" + EnvTaskId, expected: "task-id"},
+		{name: "undefined", data: "[$UNDEFINED]", expected: "[]"},
+		{name: "default undefined", data: "${UNDEFINED:-def}", expected: "def"},
+		{name: "default empty", data: "${EMPTY:-def}", expected: "def"},
+		{name: "default unused", data: "${A:-def}", expected: "a"},
+		{name: "default reference", data: "${UNDEFINED:-${B}}", expected: "a-b"},
+		{name: "default empty value", data: "${UNDEFINED:-}", expected: ""},
+		{name: "escape", data: "$$A", expected: "$A"},
+		{name: "escape braces", data: "$${A}", expected: "${A}"},
+		{name: "escape twice", data: "$$$A", expected: "$a"},
+		{name: "dollar", data: "$1 $ a$", expected: "$1 $ a$"},
+		{name: "unterminated", data: "${A", err: true},
+		{name: "invalid name", data: "${1A}", err: true},
+		{name: "empty name", data: "${}", err: true},
+		{name: "cycle", data: "$CYCLE1", err: true},
+		{name: "cycle self", data: "$SELF", err: true},
+		{name: "self in vars", data: "$PATH", expected: "/bin:/opt/bin"},
+		{name: "self default in vars", data: "$RUNNER", expected: "runner"},
+	}
+
+	for _, item := range tests {
+		t.Run(item.name, func(t *testing.T) {
+			e := newExpander(params, vars)
+			val, err := e.expand("TEST", item.data)
+			assert.Equal(t, item.err, err != nil)
+			assert.Equal(t, item.expected, val)
+		})
+	}
+
+	e := newExpander(params, vars)
+
+	_, err := e.expand("CYCLE1", "$CYCLE2")
+	assert.Equal(t, "cyclic reference: CYCLE1 -> CYCLE2 -> CYCLE3 -> CYCLE1", err.Error())
+
+	_, err = e.expand("SELF", "$SELF")
+	assert.Equal(t, "cyclic reference: SELF -> SELF", err.Error())
+}
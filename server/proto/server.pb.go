@@ -143,7 +143,9 @@ type TaskSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Task   *Task      `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Stdin  *TaskStdin `protobuf:"bytes,2,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Resize *TaskSize  `protobuf:"bytes,3,opt,name=resize,proto3" json:"resize,omitempty"`
 }
 
 func (x *TaskSpec) Reset() {
@@ -185,17 +187,43 @@ func (x *TaskSpec) GetTask() *Task {
 	return nil
 }
 
+func (x *TaskSpec) GetStdin() *TaskStdin {
+	if x != nil {
+		return x.Stdin
+	}
+	return nil
+}
+
+func (x *TaskSpec) GetResize() *TaskSize {
+	if x != nil {
+		return x.Resize
+	}
+	return nil
+}
+
 type Task struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	File     *TaskFile     `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
-	Params   []*TaskParam  `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
-	Commands []string      `protobuf:"bytes,4,rep,name=commands,proto3" json:"commands,omitempty"`
-	Log      *TaskLog      `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
-	Language *TaskLanguage `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	Name      string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	File      *TaskFile      `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Params    []*TaskParam   `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Commands  []string       `protobuf:"bytes,4,rep,name=commands,proto3" json:"commands,omitempty"`
+	Log       *TaskLog       `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	Language  *TaskLanguage  `protobuf:"bytes,6,opt,name=language,proto3" json:"language,omitempty"`
+	Timeout   int64          `protobuf:"varint,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Deadline  int64          `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Resources *TaskResources `protobuf:"bytes,9,opt,name=resources,proto3" json:"resources,omitempty"`
+	Artifacts *TaskArtifacts `protobuf:"bytes,10,opt,name=artifacts,proto3" json:"artifacts,omitempty"`
+	Bundle    *TaskBundle    `protobuf:"bytes,11,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Stdin     bool           `protobuf:"varint,12,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	Tty       bool           `protobuf:"varint,13,opt,name=tty,proto3" json:"tty,omitempty"`
+	Size      *TaskSize      `protobuf:"bytes,14,opt,name=size,proto3" json:"size,omitempty"`
+	Priority  int64          `protobuf:"varint,15,opt,name=priority,proto3" json:"priority,omitempty"`
+	Requests  *TaskRequests  `protobuf:"bytes,16,opt,name=requests,proto3" json:"requests,omitempty"`
+	Detach    bool           `protobuf:"varint,17,opt,name=detach,proto3" json:"detach,omitempty"`
+	Env       *TaskEnv       `protobuf:"bytes,18,opt,name=env,proto3" json:"env,omitempty"`
 }
 
 func (x *Task) Reset() {
@@ -272,17 +300,101 @@ func (x *Task) GetLanguage() *TaskLanguage {
 	return nil
 }
 
-type TaskFile struct {
+func (x *Task) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *Task) GetDeadline() int64 {
+	if x != nil {
+		return x.Deadline
+	}
+	return 0
+}
+
+func (x *Task) GetResources() *TaskResources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Task) GetArtifacts() *TaskArtifacts {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+func (x *Task) GetBundle() *TaskBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *Task) GetStdin() bool {
+	if x != nil {
+		return x.Stdin
+	}
+	return false
+}
+
+func (x *Task) GetTty() bool {
+	if x != nil {
+		return x.Tty
+	}
+	return false
+}
+
+func (x *Task) GetSize() *TaskSize {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *Task) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Task) GetRequests() *TaskRequests {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *Task) GetDetach() bool {
+	if x != nil {
+		return x.Detach
+	}
+	return false
+}
+
+func (x *Task) GetEnv() *TaskEnv {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+type TaskEnv struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Gzip    bool   `protobuf:"varint,2,opt,name=gzip,proto3" json:"gzip,omitempty"`
+	Policy    string   `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
 }
 
-func (x *TaskFile) Reset() {
-	*x = TaskFile{}
+func (x *TaskEnv) Reset() {
+	*x = TaskEnv{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -290,13 +402,13 @@ func (x *TaskFile) Reset() {
 	}
 }
 
-func (x *TaskFile) String() string {
+func (x *TaskEnv) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskFile) ProtoMessage() {}
+func (*TaskEnv) ProtoMessage() {}
 
-func (x *TaskFile) ProtoReflect() protoreflect.Message {
+func (x *TaskEnv) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -308,36 +420,36 @@ func (x *TaskFile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskFile.ProtoReflect.Descriptor instead.
-func (*TaskFile) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskEnv.ProtoReflect.Descriptor instead.
+func (*TaskEnv) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *TaskFile) GetContent() []byte {
+func (x *TaskEnv) GetPolicy() string {
 	if x != nil {
-		return x.Content
+		return x.Policy
 	}
-	return nil
+	return ""
 }
 
-func (x *TaskFile) GetGzip() bool {
+func (x *TaskEnv) GetAllowlist() []string {
 	if x != nil {
-		return x.Gzip
+		return x.Allowlist
 	}
-	return false
+	return nil
 }
 
-type TaskParam struct {
+type TaskStdin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Close   bool   `protobuf:"varint,2,opt,name=close,proto3" json:"close,omitempty"`
 }
 
-func (x *TaskParam) Reset() {
-	*x = TaskParam{}
+func (x *TaskStdin) Reset() {
+	*x = TaskStdin{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -345,13 +457,13 @@ func (x *TaskParam) Reset() {
 	}
 }
 
-func (x *TaskParam) String() string {
+func (x *TaskStdin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskParam) ProtoMessage() {}
+func (*TaskStdin) ProtoMessage() {}
 
-func (x *TaskParam) ProtoReflect() protoreflect.Message {
+func (x *TaskStdin) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -363,35 +475,37 @@ func (x *TaskParam) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskParam.ProtoReflect.Descriptor instead.
-func (*TaskParam) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskStdin.ProtoReflect.Descriptor instead.
+func (*TaskStdin) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TaskParam) GetName() string {
+func (x *TaskStdin) GetContent() []byte {
 	if x != nil {
-		return x.Name
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskParam) GetValue() string {
+func (x *TaskStdin) GetClose() bool {
 	if x != nil {
-		return x.Value
+		return x.Close
 	}
-	return ""
+	return false
 }
 
-type TaskLog struct {
+type TaskRequests struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Width int64 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
+	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage  int64 `protobuf:"varint,3,opt,name=storage,proto3" json:"storage,omitempty"`
 }
 
-func (x *TaskLog) Reset() {
-	*x = TaskLog{}
+func (x *TaskRequests) Reset() {
+	*x = TaskRequests{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -399,13 +513,13 @@ func (x *TaskLog) Reset() {
 	}
 }
 
-func (x *TaskLog) String() string {
+func (x *TaskRequests) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskLog) ProtoMessage() {}
+func (*TaskRequests) ProtoMessage() {}
 
-func (x *TaskLog) ProtoReflect() protoreflect.Message {
+func (x *TaskRequests) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,29 +531,43 @@ func (x *TaskLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskLog.ProtoReflect.Descriptor instead.
-func (*TaskLog) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskRequests.ProtoReflect.Descriptor instead.
+func (*TaskRequests) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TaskLog) GetWidth() int64 {
+func (x *TaskRequests) GetMilliCPU() int64 {
 	if x != nil {
-		return x.Width
+		return x.MilliCPU
 	}
 	return 0
 }
 
-type TaskLanguage struct {
+func (x *TaskRequests) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *TaskRequests) GetStorage() int64 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+type TaskSize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Artifact *TaskArtifact `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Rows uint32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Cols uint32 `protobuf:"varint,2,opt,name=cols,proto3" json:"cols,omitempty"`
 }
 
-func (x *TaskLanguage) Reset() {
-	*x = TaskLanguage{}
+func (x *TaskSize) Reset() {
+	*x = TaskSize{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,13 +575,13 @@ func (x *TaskLanguage) Reset() {
 	}
 }
 
-func (x *TaskLanguage) String() string {
+func (x *TaskSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskLanguage) ProtoMessage() {}
+func (*TaskSize) ProtoMessage() {}
 
-func (x *TaskLanguage) ProtoReflect() protoreflect.Message {
+func (x *TaskSize) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,38 +593,36 @@ func (x *TaskLanguage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskLanguage.ProtoReflect.Descriptor instead.
-func (*TaskLanguage) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskSize.ProtoReflect.Descriptor instead.
+func (*TaskSize) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TaskLanguage) GetName() string {
+func (x *TaskSize) GetRows() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Rows
 	}
-	return ""
+	return 0
 }
 
-func (x *TaskLanguage) GetArtifact() *TaskArtifact {
+func (x *TaskSize) GetCols() uint32 {
 	if x != nil {
-		return x.Artifact
+		return x.Cols
 	}
-	return nil
+	return 0
 }
 
-type TaskArtifact struct {
+type TaskFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Image   string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
-	User    string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Pass    string `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"`
-	Cleanup bool   `protobuf:"varint,4,opt,name=cleanup,proto3" json:"cleanup,omitempty"`
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Gzip    bool   `protobuf:"varint,2,opt,name=gzip,proto3" json:"gzip,omitempty"`
 }
 
-func (x *TaskArtifact) Reset() {
-	*x = TaskArtifact{}
+func (x *TaskFile) Reset() {
+	*x = TaskFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,13 +630,13 @@ func (x *TaskArtifact) Reset() {
 	}
 }
 
-func (x *TaskArtifact) String() string {
+func (x *TaskFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskArtifact) ProtoMessage() {}
+func (*TaskFile) ProtoMessage() {}
 
-func (x *TaskArtifact) ProtoReflect() protoreflect.Message {
+func (x *TaskFile) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -522,50 +648,36 @@ func (x *TaskArtifact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskArtifact.ProtoReflect.Descriptor instead.
-func (*TaskArtifact) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskFile.ProtoReflect.Descriptor instead.
+func (*TaskFile) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TaskArtifact) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
-}
-
-func (x *TaskArtifact) GetUser() string {
-	if x != nil {
-		return x.User
-	}
-	return ""
-}
-
-func (x *TaskArtifact) GetPass() string {
+func (x *TaskFile) GetContent() []byte {
 	if x != nil {
-		return x.Pass
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *TaskArtifact) GetCleanup() bool {
+func (x *TaskFile) GetGzip() bool {
 	if x != nil {
-		return x.Cleanup
+		return x.Gzip
 	}
 	return false
 }
 
-type TaskReply struct {
+type TaskBundle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Output *TaskOutput `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
-	Error  string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Entry   string `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
 }
 
-func (x *TaskReply) Reset() {
-	*x = TaskReply{}
+func (x *TaskBundle) Reset() {
+	*x = TaskBundle{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,13 +685,13 @@ func (x *TaskReply) Reset() {
 	}
 }
 
-func (x *TaskReply) String() string {
+func (x *TaskBundle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskReply) ProtoMessage() {}
+func (*TaskBundle) ProtoMessage() {}
 
-func (x *TaskReply) ProtoReflect() protoreflect.Message {
+func (x *TaskBundle) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,37 +703,37 @@ func (x *TaskReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskReply.ProtoReflect.Descriptor instead.
-func (*TaskReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskBundle.ProtoReflect.Descriptor instead.
+func (*TaskBundle) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TaskReply) GetOutput() *TaskOutput {
+func (x *TaskBundle) GetContent() []byte {
 	if x != nil {
-		return x.Output
+		return x.Content
 	}
 	return nil
 }
 
-func (x *TaskReply) GetError() string {
+func (x *TaskBundle) GetEntry() string {
 	if x != nil {
-		return x.Error
+		return x.Entry
 	}
 	return ""
 }
 
-type TaskOutput struct {
+type TaskParam struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pos     int64  `protobuf:"varint,1,opt,name=pos,proto3" json:"pos,omitempty"`
-	Time    int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Secret bool   `protobuf:"varint,3,opt,name=secret,proto3" json:"secret,omitempty"`
 }
 
-func (x *TaskOutput) Reset() {
-	*x = TaskOutput{}
+func (x *TaskParam) Reset() {
+	*x = TaskParam{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -629,13 +741,13 @@ func (x *TaskOutput) Reset() {
 	}
 }
 
-func (x *TaskOutput) String() string {
+func (x *TaskParam) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskOutput) ProtoMessage() {}
+func (*TaskParam) ProtoMessage() {}
 
-func (x *TaskOutput) ProtoReflect() protoreflect.Message {
+func (x *TaskParam) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -647,45 +759,45 @@ func (x *TaskOutput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskOutput.ProtoReflect.Descriptor instead.
-func (*TaskOutput) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskParam.ProtoReflect.Descriptor instead.
+func (*TaskParam) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TaskOutput) GetPos() int64 {
+func (x *TaskParam) GetName() string {
 	if x != nil {
-		return x.Pos
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskOutput) GetTime() int64 {
+func (x *TaskParam) GetValue() string {
 	if x != nil {
-		return x.Time
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-func (x *TaskOutput) GetMessage() string {
+func (x *TaskParam) GetSecret() bool {
 	if x != nil {
-		return x.Message
+		return x.Secret
 	}
-	return ""
+	return false
 }
 
-type GlanceRequest struct {
+type TaskResources struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string          `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
-	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *GlanceMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec       *GlanceSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
+	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Pids     int64 `protobuf:"varint,3,opt,name=pids,proto3" json:"pids,omitempty"`
+	IoWeight int64 `protobuf:"varint,4,opt,name=ioWeight,proto3" json:"ioWeight,omitempty"`
 }
 
-func (x *GlanceRequest) Reset() {
-	*x = GlanceRequest{}
+func (x *TaskResources) Reset() {
+	*x = TaskResources{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -693,13 +805,13 @@ func (x *GlanceRequest) Reset() {
 	}
 }
 
-func (x *GlanceRequest) String() string {
+func (x *TaskResources) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceRequest) ProtoMessage() {}
+func (*TaskResources) ProtoMessage() {}
 
-func (x *GlanceRequest) ProtoReflect() protoreflect.Message {
+func (x *TaskResources) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -711,49 +823,50 @@ func (x *GlanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceRequest.ProtoReflect.Descriptor instead.
-func (*GlanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskResources.ProtoReflect.Descriptor instead.
+func (*TaskResources) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GlanceRequest) GetApiVersion() string {
+func (x *TaskResources) GetMilliCPU() int64 {
 	if x != nil {
-		return x.ApiVersion
+		return x.MilliCPU
 	}
-	return ""
+	return 0
 }
 
-func (x *GlanceRequest) GetKind() string {
+func (x *TaskResources) GetMemory() int64 {
 	if x != nil {
-		return x.Kind
+		return x.Memory
 	}
-	return ""
+	return 0
 }
 
-func (x *GlanceRequest) GetMetadata() *GlanceMetadata {
+func (x *TaskResources) GetPids() int64 {
 	if x != nil {
-		return x.Metadata
+		return x.Pids
 	}
-	return nil
+	return 0
 }
 
-func (x *GlanceRequest) GetSpec() *GlanceSpec {
+func (x *TaskResources) GetIoWeight() int64 {
 	if x != nil {
-		return x.Spec
+		return x.IoWeight
 	}
-	return nil
+	return 0
 }
 
-type GlanceMetadata struct {
+type TaskArtifacts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Patterns []string `protobuf:"bytes,1,rep,name=patterns,proto3" json:"patterns,omitempty"`
+	MaxSize  int64    `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
 }
 
-func (x *GlanceMetadata) Reset() {
-	*x = GlanceMetadata{}
+func (x *TaskArtifacts) Reset() {
+	*x = TaskArtifacts{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,13 +874,13 @@ func (x *GlanceMetadata) Reset() {
 	}
 }
 
-func (x *GlanceMetadata) String() string {
+func (x *TaskArtifacts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceMetadata) ProtoMessage() {}
+func (*TaskArtifacts) ProtoMessage() {}
 
-func (x *GlanceMetadata) ProtoReflect() protoreflect.Message {
+func (x *TaskArtifacts) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,28 +892,36 @@ func (x *GlanceMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceMetadata.ProtoReflect.Descriptor instead.
-func (*GlanceMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskArtifacts.ProtoReflect.Descriptor instead.
+func (*TaskArtifacts) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GlanceMetadata) GetName() string {
+func (x *TaskArtifacts) GetPatterns() []string {
 	if x != nil {
-		return x.Name
+		return x.Patterns
 	}
-	return ""
+	return nil
 }
 
-type GlanceSpec struct {
+func (x *TaskArtifacts) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+type TaskLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Glance *Glance `protobuf:"bytes,1,opt,name=glance,proto3" json:"glance,omitempty"`
+	Width int64  `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Ansi  string `protobuf:"bytes,2,opt,name=ansi,proto3" json:"ansi,omitempty"`
 }
 
-func (x *GlanceSpec) Reset() {
-	*x = GlanceSpec{}
+func (x *TaskLog) Reset() {
+	*x = TaskLog{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -808,13 +929,13 @@ func (x *GlanceSpec) Reset() {
 	}
 }
 
-func (x *GlanceSpec) String() string {
+func (x *TaskLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceSpec) ProtoMessage() {}
+func (*TaskLog) ProtoMessage() {}
 
-func (x *GlanceSpec) ProtoReflect() protoreflect.Message {
+func (x *TaskLog) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -826,30 +947,37 @@ func (x *GlanceSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceSpec.ProtoReflect.Descriptor instead.
-func (*GlanceSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskLog.ProtoReflect.Descriptor instead.
+func (*TaskLog) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GlanceSpec) GetGlance() *Glance {
+func (x *TaskLog) GetWidth() int64 {
 	if x != nil {
-		return x.Glance
+		return x.Width
 	}
-	return nil
+	return 0
 }
 
-type Glance struct {
+func (x *TaskLog) GetAnsi() string {
+	if x != nil {
+		return x.Ansi
+	}
+	return ""
+}
+
+type TaskLanguage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dir  *GlanceDirReq  `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
-	File *GlanceFileReq `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
-	Sys  *GlanceSysReq  `protobuf:"bytes,3,opt,name=sys,proto3" json:"sys,omitempty"`
+	Name      string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Artifact  *TaskArtifact  `protobuf:"bytes,2,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Container *TaskContainer `protobuf:"bytes,3,opt,name=container,proto3" json:"container,omitempty"`
 }
 
-func (x *Glance) Reset() {
-	*x = Glance{}
+func (x *TaskLanguage) Reset() {
+	*x = TaskLanguage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -857,13 +985,13 @@ func (x *Glance) Reset() {
 	}
 }
 
-func (x *Glance) String() string {
+func (x *TaskLanguage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Glance) ProtoMessage() {}
+func (*TaskLanguage) ProtoMessage() {}
 
-func (x *Glance) ProtoReflect() protoreflect.Message {
+func (x *TaskLanguage) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -875,42 +1003,47 @@ func (x *Glance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Glance.ProtoReflect.Descriptor instead.
-func (*Glance) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskLanguage.ProtoReflect.Descriptor instead.
+func (*TaskLanguage) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Glance) GetDir() *GlanceDirReq {
+func (x *TaskLanguage) GetName() string {
 	if x != nil {
-		return x.Dir
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Glance) GetFile() *GlanceFileReq {
+func (x *TaskLanguage) GetArtifact() *TaskArtifact {
 	if x != nil {
-		return x.File
+		return x.Artifact
 	}
 	return nil
 }
 
-func (x *Glance) GetSys() *GlanceSysReq {
+func (x *TaskLanguage) GetContainer() *TaskContainer {
 	if x != nil {
-		return x.Sys
+		return x.Container
 	}
 	return nil
 }
 
-type GlanceDirReq struct {
+type TaskArtifact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Image      string `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	User       string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Pass       string `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"`
+	Cleanup    bool   `protobuf:"varint,4,opt,name=cleanup,proto3" json:"cleanup,omitempty"`
+	PullPolicy string `protobuf:"bytes,5,opt,name=pullPolicy,proto3" json:"pullPolicy,omitempty"`
+	Credential string `protobuf:"bytes,6,opt,name=credential,proto3" json:"credential,omitempty"`
 }
 
-func (x *GlanceDirReq) Reset() {
-	*x = GlanceDirReq{}
+func (x *TaskArtifact) Reset() {
+	*x = TaskArtifact{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,13 +1051,13 @@ func (x *GlanceDirReq) Reset() {
 	}
 }
 
-func (x *GlanceDirReq) String() string {
+func (x *TaskArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceDirReq) ProtoMessage() {}
+func (*TaskArtifact) ProtoMessage() {}
 
-func (x *GlanceDirReq) ProtoReflect() protoreflect.Message {
+func (x *TaskArtifact) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -936,29 +1069,69 @@ func (x *GlanceDirReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceDirReq.ProtoReflect.Descriptor instead.
-func (*GlanceDirReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskArtifact.ProtoReflect.Descriptor instead.
+func (*TaskArtifact) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GlanceDirReq) GetPath() string {
+func (x *TaskArtifact) GetImage() string {
 	if x != nil {
-		return x.Path
+		return x.Image
 	}
 	return ""
 }
 
-type GlanceFileReq struct {
+func (x *TaskArtifact) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *TaskArtifact) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+func (x *TaskArtifact) GetCleanup() bool {
+	if x != nil {
+		return x.Cleanup
+	}
+	return false
+}
+
+func (x *TaskArtifact) GetPullPolicy() string {
+	if x != nil {
+		return x.PullPolicy
+	}
+	return ""
+}
+
+func (x *TaskArtifact) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type TaskContainer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	MaxSize int64  `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	NanoCPUs int64        `protobuf:"varint,1,opt,name=nanoCPUs,proto3" json:"nanoCPUs,omitempty"`
+	Memory   int64        `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Network  string       `protobuf:"bytes,3,opt,name=network,proto3" json:"network,omitempty"`
+	User     string       `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"`
+	ReadOnly bool         `protobuf:"varint,5,opt,name=readOnly,proto3" json:"readOnly,omitempty"`
+	Env      []*TaskParam `protobuf:"bytes,6,rep,name=env,proto3" json:"env,omitempty"`
+	Tmpfs    []*TaskTmpfs `protobuf:"bytes,7,rep,name=tmpfs,proto3" json:"tmpfs,omitempty"`
 }
 
-func (x *GlanceFileReq) Reset() {
-	*x = GlanceFileReq{}
+func (x *TaskContainer) Reset() {
+	*x = TaskContainer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -966,13 +1139,13 @@ func (x *GlanceFileReq) Reset() {
 	}
 }
 
-func (x *GlanceFileReq) String() string {
+func (x *TaskContainer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceFileReq) ProtoMessage() {}
+func (*TaskContainer) ProtoMessage() {}
 
-func (x *GlanceFileReq) ProtoReflect() protoreflect.Message {
+func (x *TaskContainer) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -984,35 +1157,71 @@ func (x *GlanceFileReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceFileReq.ProtoReflect.Descriptor instead.
-func (*GlanceFileReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskContainer.ProtoReflect.Descriptor instead.
+func (*TaskContainer) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GlanceFileReq) GetPath() string {
+func (x *TaskContainer) GetNanoCPUs() int64 {
 	if x != nil {
-		return x.Path
+		return x.NanoCPUs
 	}
-	return ""
+	return 0
 }
 
-func (x *GlanceFileReq) GetMaxSize() int64 {
+func (x *TaskContainer) GetMemory() int64 {
 	if x != nil {
-		return x.MaxSize
+		return x.Memory
 	}
 	return 0
 }
 
-type GlanceSysReq struct {
+func (x *TaskContainer) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *TaskContainer) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *TaskContainer) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *TaskContainer) GetEnv() []*TaskParam {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *TaskContainer) GetTmpfs() []*TaskTmpfs {
+	if x != nil {
+		return x.Tmpfs
+	}
+	return nil
+}
+
+type TaskTmpfs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Size   int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *GlanceSysReq) Reset() {
-	*x = GlanceSysReq{}
+func (x *TaskTmpfs) Reset() {
+	*x = TaskTmpfs{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,13 +1229,13 @@ func (x *GlanceSysReq) Reset() {
 	}
 }
 
-func (x *GlanceSysReq) String() string {
+func (x *TaskTmpfs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceSysReq) ProtoMessage() {}
+func (*TaskTmpfs) ProtoMessage() {}
 
-func (x *GlanceSysReq) ProtoReflect() protoreflect.Message {
+func (x *TaskTmpfs) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1038,31 +1247,41 @@ func (x *GlanceSysReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceSysReq.ProtoReflect.Descriptor instead.
-func (*GlanceSysReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskTmpfs.ProtoReflect.Descriptor instead.
+func (*TaskTmpfs) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GlanceSysReq) GetEnable() bool {
+func (x *TaskTmpfs) GetTarget() string {
 	if x != nil {
-		return x.Enable
+		return x.Target
 	}
-	return false
+	return ""
 }
 
-type GlanceReply struct {
+func (x *TaskTmpfs) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type TaskReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Dir   *GlanceDirRep  `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
-	File  *GlanceFileRep `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
-	Sys   *GlanceSysRep  `protobuf:"bytes,3,opt,name=sys,proto3" json:"sys,omitempty"`
-	Error string         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Output   *TaskOutput   `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Error    string        `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Status   *TaskStatus   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Id       string        `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Archive  *TaskArchive  `protobuf:"bytes,5,opt,name=archive,proto3" json:"archive,omitempty"`
+	Progress *TaskProgress `protobuf:"bytes,6,opt,name=progress,proto3" json:"progress,omitempty"`
+	Queue    *TaskQueue    `protobuf:"bytes,7,opt,name=queue,proto3" json:"queue,omitempty"`
 }
 
-func (x *GlanceReply) Reset() {
-	*x = GlanceReply{}
+func (x *TaskReply) Reset() {
+	*x = TaskReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1070,13 +1289,13 @@ func (x *GlanceReply) Reset() {
 	}
 }
 
-func (x *GlanceReply) String() string {
+func (x *TaskReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceReply) ProtoMessage() {}
+func (*TaskReply) ProtoMessage() {}
 
-func (x *GlanceReply) ProtoReflect() protoreflect.Message {
+func (x *TaskReply) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1088,49 +1307,70 @@ func (x *GlanceReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceReply.ProtoReflect.Descriptor instead.
-func (*GlanceReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskReply.ProtoReflect.Descriptor instead.
+func (*TaskReply) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GlanceReply) GetDir() *GlanceDirRep {
+func (x *TaskReply) GetOutput() *TaskOutput {
 	if x != nil {
-		return x.Dir
+		return x.Output
 	}
 	return nil
 }
 
-func (x *GlanceReply) GetFile() *GlanceFileRep {
+func (x *TaskReply) GetError() string {
 	if x != nil {
-		return x.File
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *GlanceReply) GetSys() *GlanceSysRep {
+func (x *TaskReply) GetStatus() *TaskStatus {
 	if x != nil {
-		return x.Sys
+		return x.Status
 	}
 	return nil
 }
 
-func (x *GlanceReply) GetError() string {
+func (x *TaskReply) GetId() string {
 	if x != nil {
-		return x.Error
+		return x.Id
 	}
 	return ""
 }
 
-type GlanceDirRep struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Entries []*GlanceEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+func (x *TaskReply) GetArchive() *TaskArchive {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
 }
 
-func (x *GlanceDirRep) Reset() {
-	*x = GlanceDirRep{}
+func (x *TaskReply) GetProgress() *TaskProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *TaskReply) GetQueue() *TaskQueue {
+	if x != nil {
+		return x.Queue
+	}
+	return nil
+}
+
+type TaskQueue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *TaskQueue) Reset() {
+	*x = TaskQueue{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1138,13 +1378,13 @@ func (x *GlanceDirRep) Reset() {
 	}
 }
 
-func (x *GlanceDirRep) String() string {
+func (x *TaskQueue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceDirRep) ProtoMessage() {}
+func (*TaskQueue) ProtoMessage() {}
 
-func (x *GlanceDirRep) ProtoReflect() protoreflect.Message {
+func (x *TaskQueue) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1156,34 +1396,32 @@ func (x *GlanceDirRep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceDirRep.ProtoReflect.Descriptor instead.
-func (*GlanceDirRep) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskQueue.ProtoReflect.Descriptor instead.
+func (*TaskQueue) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GlanceDirRep) GetEntries() []*GlanceEntry {
+func (x *TaskQueue) GetPosition() int64 {
 	if x != nil {
-		return x.Entries
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-type GlanceEntry struct {
+type TaskOutput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	IsDir bool   `protobuf:"varint,2,opt,name=isDir,proto3" json:"isDir,omitempty"`
-	Size  int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Time  string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
-	User  string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
-	Group string `protobuf:"bytes,6,opt,name=group,proto3" json:"group,omitempty"`
-	Mode  string `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
+	Pos     int64  `protobuf:"varint,1,opt,name=pos,proto3" json:"pos,omitempty"`
+	Time    int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Stream  string `protobuf:"bytes,4,opt,name=stream,proto3" json:"stream,omitempty"`
+	Seq     int64  `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (x *GlanceEntry) Reset() {
-	*x = GlanceEntry{}
+func (x *TaskOutput) Reset() {
+	*x = TaskOutput{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1191,13 +1429,13 @@ func (x *GlanceEntry) Reset() {
 	}
 }
 
-func (x *GlanceEntry) String() string {
+func (x *TaskOutput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceEntry) ProtoMessage() {}
+func (*TaskOutput) ProtoMessage() {}
 
-func (x *GlanceEntry) ProtoReflect() protoreflect.Message {
+func (x *TaskOutput) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1209,71 +1447,59 @@ func (x *GlanceEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceEntry.ProtoReflect.Descriptor instead.
-func (*GlanceEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskOutput.ProtoReflect.Descriptor instead.
+func (*TaskOutput) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GlanceEntry) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GlanceEntry) GetIsDir() bool {
-	if x != nil {
-		return x.IsDir
-	}
-	return false
-}
-
-func (x *GlanceEntry) GetSize() int64 {
+func (x *TaskOutput) GetPos() int64 {
 	if x != nil {
-		return x.Size
+		return x.Pos
 	}
 	return 0
 }
 
-func (x *GlanceEntry) GetTime() string {
+func (x *TaskOutput) GetTime() int64 {
 	if x != nil {
 		return x.Time
 	}
-	return ""
+	return 0
 }
 
-func (x *GlanceEntry) GetUser() string {
+func (x *TaskOutput) GetMessage() string {
 	if x != nil {
-		return x.User
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GlanceEntry) GetGroup() string {
+func (x *TaskOutput) GetStream() string {
 	if x != nil {
-		return x.Group
+		return x.Stream
 	}
 	return ""
 }
 
-func (x *GlanceEntry) GetMode() string {
+func (x *TaskOutput) GetSeq() int64 {
 	if x != nil {
-		return x.Mode
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-type GlanceFileRep struct {
+type TaskProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
-	Readable bool   `protobuf:"varint,2,opt,name=readable,proto3" json:"readable,omitempty"`
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Current int64  `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
+	Total   int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *GlanceFileRep) Reset() {
-	*x = GlanceFileRep{}
+func (x *TaskProgress) Reset() {
+	*x = TaskProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1281,13 +1507,13 @@ func (x *GlanceFileRep) Reset() {
 	}
 }
 
-func (x *GlanceFileRep) String() string {
+func (x *TaskProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceFileRep) ProtoMessage() {}
+func (*TaskProgress) ProtoMessage() {}
 
-func (x *GlanceFileRep) ProtoReflect() protoreflect.Message {
+func (x *TaskProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,36 +1525,52 @@ func (x *GlanceFileRep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceFileRep.ProtoReflect.Descriptor instead.
-func (*GlanceFileRep) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskProgress.ProtoReflect.Descriptor instead.
+func (*TaskProgress) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GlanceFileRep) GetContent() string {
+func (x *TaskProgress) GetId() string {
 	if x != nil {
-		return x.Content
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GlanceFileRep) GetReadable() bool {
+func (x *TaskProgress) GetStatus() string {
 	if x != nil {
-		return x.Readable
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-type GlanceSysRep struct {
+func (x *TaskProgress) GetCurrent() int64 {
+	if x != nil {
+		return x.Current
+	}
+	return 0
+}
+
+func (x *TaskProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type TaskArchive struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Resource *GlanceResource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
-	Stats    *GlanceStats    `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	Pos       int64           `protobuf:"varint,1,opt,name=pos,proto3" json:"pos,omitempty"`
+	Content   []byte          `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Last      bool            `protobuf:"varint,3,opt,name=last,proto3" json:"last,omitempty"`
+	Checksums []*TaskChecksum `protobuf:"bytes,4,rep,name=checksums,proto3" json:"checksums,omitempty"`
 }
 
-func (x *GlanceSysRep) Reset() {
-	*x = GlanceSysRep{}
+func (x *TaskArchive) Reset() {
+	*x = TaskArchive{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1336,13 +1578,13 @@ func (x *GlanceSysRep) Reset() {
 	}
 }
 
-func (x *GlanceSysRep) String() string {
+func (x *TaskArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceSysRep) ProtoMessage() {}
+func (*TaskArchive) ProtoMessage() {}
 
-func (x *GlanceSysRep) ProtoReflect() protoreflect.Message {
+func (x *TaskArchive) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1354,36 +1596,51 @@ func (x *GlanceSysRep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceSysRep.ProtoReflect.Descriptor instead.
-func (*GlanceSysRep) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskArchive.ProtoReflect.Descriptor instead.
+func (*TaskArchive) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GlanceSysRep) GetResource() *GlanceResource {
+func (x *TaskArchive) GetPos() int64 {
 	if x != nil {
-		return x.Resource
+		return x.Pos
+	}
+	return 0
+}
+
+func (x *TaskArchive) GetContent() []byte {
+	if x != nil {
+		return x.Content
 	}
 	return nil
 }
 
-func (x *GlanceSysRep) GetStats() *GlanceStats {
+func (x *TaskArchive) GetLast() bool {
 	if x != nil {
-		return x.Stats
+		return x.Last
+	}
+	return false
+}
+
+func (x *TaskArchive) GetChecksums() []*TaskChecksum {
+	if x != nil {
+		return x.Checksums
 	}
 	return nil
 }
 
-type GlanceResource struct {
+type TaskChecksum struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Allocatable *GlanceAllocatable `protobuf:"bytes,1,opt,name=allocatable,proto3" json:"allocatable,omitempty"`
-	Requested   *GlanceRequested   `protobuf:"bytes,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size   int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
 }
 
-func (x *GlanceResource) Reset() {
-	*x = GlanceResource{}
+func (x *TaskChecksum) Reset() {
+	*x = TaskChecksum{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1391,13 +1648,13 @@ func (x *GlanceResource) Reset() {
 	}
 }
 
-func (x *GlanceResource) String() string {
+func (x *TaskChecksum) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceResource) ProtoMessage() {}
+func (*TaskChecksum) ProtoMessage() {}
 
-func (x *GlanceResource) ProtoReflect() protoreflect.Message {
+func (x *TaskChecksum) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1409,37 +1666,51 @@ func (x *GlanceResource) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceResource.ProtoReflect.Descriptor instead.
-func (*GlanceResource) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskChecksum.ProtoReflect.Descriptor instead.
+func (*TaskChecksum) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *GlanceResource) GetAllocatable() *GlanceAllocatable {
+func (x *TaskChecksum) GetName() string {
 	if x != nil {
-		return x.Allocatable
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GlanceResource) GetRequested() *GlanceRequested {
+func (x *TaskChecksum) GetSize() int64 {
 	if x != nil {
-		return x.Requested
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-type GlanceAllocatable struct {
+func (x *TaskChecksum) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type TaskStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
-	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	Storage  int64 `protobuf:"varint,3,opt,name=storage,proto3" json:"storage,omitempty"`
+	ExitCode   int64             `protobuf:"varint,1,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Signal     string            `protobuf:"bytes,2,opt,name=signal,proto3" json:"signal,omitempty"`
+	OomKilled  bool              `protobuf:"varint,3,opt,name=oomKilled,proto3" json:"oomKilled,omitempty"`
+	Reason     string            `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	StartTime  int64             `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime    int64             `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Duration   int64             `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	MemoryPeak int64             `protobuf:"varint,8,opt,name=memoryPeak,proto3" json:"memoryPeak,omitempty"`
+	CpuTime    int64             `protobuf:"varint,9,opt,name=cpuTime,proto3" json:"cpuTime,omitempty"`
+	Outputs    map[string]string `protobuf:"bytes,10,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GlanceAllocatable) Reset() {
-	*x = GlanceAllocatable{}
+func (x *TaskStatus) Reset() {
+	*x = TaskStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1447,13 +1718,13 @@ func (x *GlanceAllocatable) Reset() {
 	}
 }
 
-func (x *GlanceAllocatable) String() string {
+func (x *TaskStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceAllocatable) ProtoMessage() {}
+func (*TaskStatus) ProtoMessage() {}
 
-func (x *GlanceAllocatable) ProtoReflect() protoreflect.Message {
+func (x *TaskStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1465,44 +1736,94 @@ func (x *GlanceAllocatable) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceAllocatable.ProtoReflect.Descriptor instead.
-func (*GlanceAllocatable) Descriptor() ([]byte, []int) {
+// Deprecated: Use TaskStatus.ProtoReflect.Descriptor instead.
+func (*TaskStatus) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GlanceAllocatable) GetMilliCPU() int64 {
+func (x *TaskStatus) GetExitCode() int64 {
 	if x != nil {
-		return x.MilliCPU
+		return x.ExitCode
 	}
 	return 0
 }
 
-func (x *GlanceAllocatable) GetMemory() int64 {
+func (x *TaskStatus) GetSignal() string {
 	if x != nil {
-		return x.Memory
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *TaskStatus) GetOomKilled() bool {
+	if x != nil {
+		return x.OomKilled
+	}
+	return false
+}
+
+func (x *TaskStatus) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TaskStatus) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *GlanceAllocatable) GetStorage() int64 {
+func (x *TaskStatus) GetEndTime() int64 {
 	if x != nil {
-		return x.Storage
+		return x.EndTime
 	}
 	return 0
 }
 
-type GlanceRequested struct {
+func (x *TaskStatus) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TaskStatus) GetMemoryPeak() int64 {
+	if x != nil {
+		return x.MemoryPeak
+	}
+	return 0
+}
+
+func (x *TaskStatus) GetCpuTime() int64 {
+	if x != nil {
+		return x.CpuTime
+	}
+	return 0
+}
+
+func (x *TaskStatus) GetOutputs() map[string]string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type GlanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
-	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
-	Storage  int64 `protobuf:"varint,3,opt,name=storage,proto3" json:"storage,omitempty"`
+	ApiVersion string          `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *GlanceMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *GlanceSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
 }
 
-func (x *GlanceRequested) Reset() {
-	*x = GlanceRequested{}
+func (x *GlanceRequest) Reset() {
+	*x = GlanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1510,13 +1831,13 @@ func (x *GlanceRequested) Reset() {
 	}
 }
 
-func (x *GlanceRequested) String() string {
+func (x *GlanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceRequested) ProtoMessage() {}
+func (*GlanceRequest) ProtoMessage() {}
 
-func (x *GlanceRequested) ProtoReflect() protoreflect.Message {
+func (x *GlanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1528,47 +1849,49 @@ func (x *GlanceRequested) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceRequested.ProtoReflect.Descriptor instead.
-func (*GlanceRequested) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceRequest.ProtoReflect.Descriptor instead.
+func (*GlanceRequest) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GlanceRequested) GetMilliCPU() int64 {
+func (x *GlanceRequest) GetApiVersion() string {
 	if x != nil {
-		return x.MilliCPU
+		return x.ApiVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *GlanceRequested) GetMemory() int64 {
+func (x *GlanceRequest) GetKind() string {
 	if x != nil {
-		return x.Memory
+		return x.Kind
 	}
-	return 0
+	return ""
 }
 
-func (x *GlanceRequested) GetStorage() int64 {
+func (x *GlanceRequest) GetMetadata() *GlanceMetadata {
 	if x != nil {
-		return x.Storage
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-type GlanceStats struct {
+func (x *GlanceRequest) GetSpec() *GlanceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type GlanceMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cpu       *GlanceCPU       `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Host      string           `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Memory    *GlanceMemory    `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	Os        string           `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
-	Storage   *GlanceStorage   `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
-	Processes []*GlanceProcess `protobuf:"bytes,6,rep,name=processes,proto3" json:"processes,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GlanceStats) Reset() {
-	*x = GlanceStats{}
+func (x *GlanceMetadata) Reset() {
+	*x = GlanceMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1576,13 +1899,13 @@ func (x *GlanceStats) Reset() {
 	}
 }
 
-func (x *GlanceStats) String() string {
+func (x *GlanceMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceStats) ProtoMessage() {}
+func (*GlanceMetadata) ProtoMessage() {}
 
-func (x *GlanceStats) ProtoReflect() protoreflect.Message {
+func (x *GlanceMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1594,64 +1917,28 @@ func (x *GlanceStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceStats.ProtoReflect.Descriptor instead.
-func (*GlanceStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceMetadata.ProtoReflect.Descriptor instead.
+func (*GlanceMetadata) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GlanceStats) GetCpu() *GlanceCPU {
-	if x != nil {
-		return x.Cpu
-	}
-	return nil
-}
-
-func (x *GlanceStats) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *GlanceStats) GetMemory() *GlanceMemory {
-	if x != nil {
-		return x.Memory
-	}
-	return nil
-}
-
-func (x *GlanceStats) GetOs() string {
+func (x *GlanceMetadata) GetName() string {
 	if x != nil {
-		return x.Os
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GlanceStats) GetStorage() *GlanceStorage {
-	if x != nil {
-		return x.Storage
-	}
-	return nil
-}
-
-func (x *GlanceStats) GetProcesses() []*GlanceProcess {
-	if x != nil {
-		return x.Processes
-	}
-	return nil
-}
-
-type GlanceCPU struct {
+type GlanceSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
-	Used  string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
+	Glance *Glance `protobuf:"bytes,1,opt,name=glance,proto3" json:"glance,omitempty"`
 }
 
-func (x *GlanceCPU) Reset() {
-	*x = GlanceCPU{}
+func (x *GlanceSpec) Reset() {
+	*x = GlanceSpec{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1659,13 +1946,13 @@ func (x *GlanceCPU) Reset() {
 	}
 }
 
-func (x *GlanceCPU) String() string {
+func (x *GlanceSpec) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceCPU) ProtoMessage() {}
+func (*GlanceSpec) ProtoMessage() {}
 
-func (x *GlanceCPU) ProtoReflect() protoreflect.Message {
+func (x *GlanceSpec) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1677,36 +1964,30 @@ func (x *GlanceCPU) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceCPU.ProtoReflect.Descriptor instead.
-func (*GlanceCPU) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceSpec.ProtoReflect.Descriptor instead.
+func (*GlanceSpec) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GlanceCPU) GetTotal() string {
-	if x != nil {
-		return x.Total
-	}
-	return ""
-}
-
-func (x *GlanceCPU) GetUsed() string {
+func (x *GlanceSpec) GetGlance() *Glance {
 	if x != nil {
-		return x.Used
+		return x.Glance
 	}
-	return ""
+	return nil
 }
 
-type GlanceMemory struct {
+type Glance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
-	Used  string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
+	Dir  *GlanceDirReq  `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	File *GlanceFileReq `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Sys  *GlanceSysReq  `protobuf:"bytes,3,opt,name=sys,proto3" json:"sys,omitempty"`
 }
 
-func (x *GlanceMemory) Reset() {
-	*x = GlanceMemory{}
+func (x *Glance) Reset() {
+	*x = Glance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1714,13 +1995,13 @@ func (x *GlanceMemory) Reset() {
 	}
 }
 
-func (x *GlanceMemory) String() string {
+func (x *Glance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceMemory) ProtoMessage() {}
+func (*Glance) ProtoMessage() {}
 
-func (x *GlanceMemory) ProtoReflect() protoreflect.Message {
+func (x *Glance) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1732,36 +2013,42 @@ func (x *GlanceMemory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceMemory.ProtoReflect.Descriptor instead.
-func (*GlanceMemory) Descriptor() ([]byte, []int) {
+// Deprecated: Use Glance.ProtoReflect.Descriptor instead.
+func (*Glance) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GlanceMemory) GetTotal() string {
+func (x *Glance) GetDir() *GlanceDirReq {
 	if x != nil {
-		return x.Total
+		return x.Dir
 	}
-	return ""
+	return nil
 }
 
-func (x *GlanceMemory) GetUsed() string {
+func (x *Glance) GetFile() *GlanceFileReq {
 	if x != nil {
-		return x.Used
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-type GlanceStorage struct {
+func (x *Glance) GetSys() *GlanceSysReq {
+	if x != nil {
+		return x.Sys
+	}
+	return nil
+}
+
+type GlanceDirReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
-	Used  string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *GlanceStorage) Reset() {
-	*x = GlanceStorage{}
+func (x *GlanceDirReq) Reset() {
+	*x = GlanceDirReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1769,13 +2056,13 @@ func (x *GlanceStorage) Reset() {
 	}
 }
 
-func (x *GlanceStorage) String() string {
+func (x *GlanceDirReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceStorage) ProtoMessage() {}
+func (*GlanceDirReq) ProtoMessage() {}
 
-func (x *GlanceStorage) ProtoReflect() protoreflect.Message {
+func (x *GlanceDirReq) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1787,36 +2074,29 @@ func (x *GlanceStorage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceStorage.ProtoReflect.Descriptor instead.
-func (*GlanceStorage) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceDirReq.ProtoReflect.Descriptor instead.
+func (*GlanceDirReq) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GlanceStorage) GetTotal() string {
-	if x != nil {
-		return x.Total
-	}
-	return ""
-}
-
-func (x *GlanceStorage) GetUsed() string {
+func (x *GlanceDirReq) GetPath() string {
 	if x != nil {
-		return x.Used
+		return x.Path
 	}
 	return ""
 }
 
-type GlanceProcess struct {
+type GlanceFileReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Process *GlanceThread   `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
-	Threads []*GlanceThread `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
+	Path    string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	MaxSize int64  `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
 }
 
-func (x *GlanceProcess) Reset() {
-	*x = GlanceProcess{}
+func (x *GlanceFileReq) Reset() {
+	*x = GlanceFileReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1824,13 +2104,13 @@ func (x *GlanceProcess) Reset() {
 	}
 }
 
-func (x *GlanceProcess) String() string {
+func (x *GlanceFileReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceProcess) ProtoMessage() {}
+func (*GlanceFileReq) ProtoMessage() {}
 
-func (x *GlanceProcess) ProtoReflect() protoreflect.Message {
+func (x *GlanceFileReq) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,39 +2122,35 @@ func (x *GlanceProcess) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceProcess.ProtoReflect.Descriptor instead.
-func (*GlanceProcess) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceFileReq.ProtoReflect.Descriptor instead.
+func (*GlanceFileReq) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GlanceProcess) GetProcess() *GlanceThread {
+func (x *GlanceFileReq) GetPath() string {
 	if x != nil {
-		return x.Process
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *GlanceProcess) GetThreads() []*GlanceThread {
+func (x *GlanceFileReq) GetMaxSize() int64 {
 	if x != nil {
-		return x.Threads
+		return x.MaxSize
 	}
-	return nil
+	return 0
 }
 
-type GlanceThread struct {
+type GlanceSysReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Cmdline string  `protobuf:"bytes,2,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
-	Memory  int64   `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	Time    float64 `protobuf:"fixed64,4,opt,name=time,proto3" json:"time,omitempty"`
-	Pid     int64   `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
 }
 
-func (x *GlanceThread) Reset() {
-	*x = GlanceThread{}
+func (x *GlanceSysReq) Reset() {
+	*x = GlanceSysReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1882,13 +2158,13 @@ func (x *GlanceThread) Reset() {
 	}
 }
 
-func (x *GlanceThread) String() string {
+func (x *GlanceSysReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlanceThread) ProtoMessage() {}
+func (*GlanceSysReq) ProtoMessage() {}
 
-func (x *GlanceThread) ProtoReflect() protoreflect.Message {
+func (x *GlanceSysReq) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1900,73 +2176,45 @@ func (x *GlanceThread) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlanceThread.ProtoReflect.Descriptor instead.
-func (*GlanceThread) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceSysReq.ProtoReflect.Descriptor instead.
+func (*GlanceSysReq) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GlanceThread) GetName() string {
+func (x *GlanceSysReq) GetEnable() bool {
 	if x != nil {
-		return x.Name
+		return x.Enable
 	}
-	return ""
+	return false
 }
 
-func (x *GlanceThread) GetCmdline() string {
-	if x != nil {
-		return x.Cmdline
-	}
-	return ""
+type GlanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dir   *GlanceDirRep  `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	File  *GlanceFileRep `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Sys   *GlanceSysRep  `protobuf:"bytes,3,opt,name=sys,proto3" json:"sys,omitempty"`
+	Error string         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GlanceThread) GetMemory() int64 {
-	if x != nil {
-		return x.Memory
+func (x *GlanceReply) Reset() {
+	*x = GlanceReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *GlanceThread) GetTime() float64 {
-	if x != nil {
-		return x.Time
-	}
-	return 0
-}
-
-func (x *GlanceThread) GetPid() int64 {
-	if x != nil {
-		return x.Pid
-	}
-	return 0
-}
-
-type MaintRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ApiVersion string         `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
-	Kind       string         `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *MaintMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec       *MaintSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
-}
-
-func (x *MaintRequest) Reset() {
-	*x = MaintRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_server_proto_server_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MaintRequest) String() string {
+func (x *GlanceReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintRequest) ProtoMessage() {}
+func (*GlanceReply) ProtoMessage() {}
 
-func (x *MaintRequest) ProtoReflect() protoreflect.Message {
+func (x *GlanceReply) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1978,49 +2226,49 @@ func (x *MaintRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintRequest.ProtoReflect.Descriptor instead.
-func (*MaintRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceReply.ProtoReflect.Descriptor instead.
+func (*GlanceReply) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *MaintRequest) GetApiVersion() string {
+func (x *GlanceReply) GetDir() *GlanceDirRep {
 	if x != nil {
-		return x.ApiVersion
+		return x.Dir
 	}
-	return ""
+	return nil
 }
 
-func (x *MaintRequest) GetKind() string {
+func (x *GlanceReply) GetFile() *GlanceFileRep {
 	if x != nil {
-		return x.Kind
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-func (x *MaintRequest) GetMetadata() *MaintMetadata {
+func (x *GlanceReply) GetSys() *GlanceSysRep {
 	if x != nil {
-		return x.Metadata
+		return x.Sys
 	}
 	return nil
 }
 
-func (x *MaintRequest) GetSpec() *MaintSpec {
+func (x *GlanceReply) GetError() string {
 	if x != nil {
-		return x.Spec
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type MaintMetadata struct {
+type GlanceDirRep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entries []*GlanceEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *MaintMetadata) Reset() {
-	*x = MaintMetadata{}
+func (x *GlanceDirRep) Reset() {
+	*x = GlanceDirRep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2028,13 +2276,13 @@ func (x *MaintMetadata) Reset() {
 	}
 }
 
-func (x *MaintMetadata) String() string {
+func (x *GlanceDirRep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintMetadata) ProtoMessage() {}
+func (*GlanceDirRep) ProtoMessage() {}
 
-func (x *MaintMetadata) ProtoReflect() protoreflect.Message {
+func (x *GlanceDirRep) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2046,28 +2294,34 @@ func (x *MaintMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintMetadata.ProtoReflect.Descriptor instead.
-func (*MaintMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceDirRep.ProtoReflect.Descriptor instead.
+func (*GlanceDirRep) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *MaintMetadata) GetName() string {
+func (x *GlanceDirRep) GetEntries() []*GlanceEntry {
 	if x != nil {
-		return x.Name
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type MaintSpec struct {
+type GlanceEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Maint *Maint `protobuf:"bytes,1,opt,name=maint,proto3" json:"maint,omitempty"`
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDir bool   `protobuf:"varint,2,opt,name=isDir,proto3" json:"isDir,omitempty"`
+	Size  int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Time  string `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	User  string `protobuf:"bytes,5,opt,name=user,proto3" json:"user,omitempty"`
+	Group string `protobuf:"bytes,6,opt,name=group,proto3" json:"group,omitempty"`
+	Mode  string `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"`
 }
 
-func (x *MaintSpec) Reset() {
-	*x = MaintSpec{}
+func (x *GlanceEntry) Reset() {
+	*x = GlanceEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2075,13 +2329,13 @@ func (x *MaintSpec) Reset() {
 	}
 }
 
-func (x *MaintSpec) String() string {
+func (x *GlanceEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintSpec) ProtoMessage() {}
+func (*GlanceEntry) ProtoMessage() {}
 
-func (x *MaintSpec) ProtoReflect() protoreflect.Message {
+func (x *GlanceEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2093,28 +2347,71 @@ func (x *MaintSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintSpec.ProtoReflect.Descriptor instead.
-func (*MaintSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceEntry.ProtoReflect.Descriptor instead.
+func (*GlanceEntry) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *MaintSpec) GetMaint() *Maint {
+func (x *GlanceEntry) GetName() string {
 	if x != nil {
-		return x.Maint
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type Maint struct {
+func (x *GlanceEntry) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *GlanceEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GlanceEntry) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GlanceEntry) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GlanceEntry) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *GlanceEntry) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+type GlanceFileRep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clock *MaintClockReq `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Content  string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Readable bool   `protobuf:"varint,2,opt,name=readable,proto3" json:"readable,omitempty"`
 }
 
-func (x *Maint) Reset() {
-	*x = Maint{}
+func (x *GlanceFileRep) Reset() {
+	*x = GlanceFileRep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,13 +2419,13 @@ func (x *Maint) Reset() {
 	}
 }
 
-func (x *Maint) String() string {
+func (x *GlanceFileRep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Maint) ProtoMessage() {}
+func (*GlanceFileRep) ProtoMessage() {}
 
-func (x *Maint) ProtoReflect() protoreflect.Message {
+func (x *GlanceFileRep) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2140,29 +2437,37 @@ func (x *Maint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Maint.ProtoReflect.Descriptor instead.
-func (*Maint) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceFileRep.ProtoReflect.Descriptor instead.
+func (*GlanceFileRep) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *Maint) GetClock() *MaintClockReq {
+func (x *GlanceFileRep) GetContent() string {
 	if x != nil {
-		return x.Clock
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type MaintClockReq struct {
+func (x *GlanceFileRep) GetReadable() bool {
+	if x != nil {
+		return x.Readable
+	}
+	return false
+}
+
+type GlanceSysRep struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sync bool  `protobuf:"varint,1,opt,name=sync,proto3" json:"sync,omitempty"`
-	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Resource *GlanceResource `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Stats    *GlanceStats    `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	Reserved *GlanceReserved `protobuf:"bytes,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
 }
 
-func (x *MaintClockReq) Reset() {
-	*x = MaintClockReq{}
+func (x *GlanceSysRep) Reset() {
+	*x = GlanceSysRep{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2170,13 +2475,13 @@ func (x *MaintClockReq) Reset() {
 	}
 }
 
-func (x *MaintClockReq) String() string {
+func (x *GlanceSysRep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintClockReq) ProtoMessage() {}
+func (*GlanceSysRep) ProtoMessage() {}
 
-func (x *MaintClockReq) ProtoReflect() protoreflect.Message {
+func (x *GlanceSysRep) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2188,35 +2493,43 @@ func (x *MaintClockReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintClockReq.ProtoReflect.Descriptor instead.
-func (*MaintClockReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceSysRep.ProtoReflect.Descriptor instead.
+func (*GlanceSysRep) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MaintClockReq) GetSync() bool {
+func (x *GlanceSysRep) GetResource() *GlanceResource {
 	if x != nil {
-		return x.Sync
+		return x.Resource
 	}
-	return false
+	return nil
 }
 
-func (x *MaintClockReq) GetTime() int64 {
+func (x *GlanceSysRep) GetStats() *GlanceStats {
 	if x != nil {
-		return x.Time
+		return x.Stats
 	}
-	return 0
+	return nil
 }
 
-type MaintReply struct {
+func (x *GlanceSysRep) GetReserved() *GlanceReserved {
+	if x != nil {
+		return x.Reserved
+	}
+	return nil
+}
+
+type GlanceResource struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clock *MaintClockRep `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Allocatable *GlanceAllocatable `protobuf:"bytes,1,opt,name=allocatable,proto3" json:"allocatable,omitempty"`
+	Requested   *GlanceRequested   `protobuf:"bytes,2,opt,name=requested,proto3" json:"requested,omitempty"`
 }
 
-func (x *MaintReply) Reset() {
-	*x = MaintReply{}
+func (x *GlanceResource) Reset() {
+	*x = GlanceResource{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2224,13 +2537,13 @@ func (x *MaintReply) Reset() {
 	}
 }
 
-func (x *MaintReply) String() string {
+func (x *GlanceResource) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintReply) ProtoMessage() {}
+func (*GlanceResource) ProtoMessage() {}
 
-func (x *MaintReply) ProtoReflect() protoreflect.Message {
+func (x *GlanceResource) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2242,29 +2555,37 @@ func (x *MaintReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintReply.ProtoReflect.Descriptor instead.
-func (*MaintReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceResource.ProtoReflect.Descriptor instead.
+func (*GlanceResource) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *MaintReply) GetClock() *MaintClockRep {
+func (x *GlanceResource) GetAllocatable() *GlanceAllocatable {
 	if x != nil {
-		return x.Clock
+		return x.Allocatable
 	}
 	return nil
 }
 
-type MaintClockRep struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
+func (x *GlanceResource) GetRequested() *GlanceRequested {
+	if x != nil {
+		return x.Requested
+	}
+	return nil
+}
+
+type GlanceAllocatable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sync *MaintClockSync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
-	Diff *MaintClockDiff `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
+	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage  int64 `protobuf:"varint,3,opt,name=storage,proto3" json:"storage,omitempty"`
 }
 
-func (x *MaintClockRep) Reset() {
-	*x = MaintClockRep{}
+func (x *GlanceAllocatable) Reset() {
+	*x = GlanceAllocatable{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2272,13 +2593,13 @@ func (x *MaintClockRep) Reset() {
 	}
 }
 
-func (x *MaintClockRep) String() string {
+func (x *GlanceAllocatable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintClockRep) ProtoMessage() {}
+func (*GlanceAllocatable) ProtoMessage() {}
 
-func (x *MaintClockRep) ProtoReflect() protoreflect.Message {
+func (x *GlanceAllocatable) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2290,35 +2611,44 @@ func (x *MaintClockRep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintClockRep.ProtoReflect.Descriptor instead.
-func (*MaintClockRep) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceAllocatable.ProtoReflect.Descriptor instead.
+func (*GlanceAllocatable) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MaintClockRep) GetSync() *MaintClockSync {
+func (x *GlanceAllocatable) GetMilliCPU() int64 {
 	if x != nil {
-		return x.Sync
+		return x.MilliCPU
 	}
-	return nil
+	return 0
 }
 
-func (x *MaintClockRep) GetDiff() *MaintClockDiff {
+func (x *GlanceAllocatable) GetMemory() int64 {
 	if x != nil {
-		return x.Diff
+		return x.Memory
 	}
-	return nil
+	return 0
 }
 
-type MaintClockSync struct {
+func (x *GlanceAllocatable) GetStorage() int64 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+type GlanceRequested struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
+	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage  int64 `protobuf:"varint,3,opt,name=storage,proto3" json:"storage,omitempty"`
 }
 
-func (x *MaintClockSync) Reset() {
-	*x = MaintClockSync{}
+func (x *GlanceRequested) Reset() {
+	*x = GlanceRequested{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2326,13 +2656,13 @@ func (x *MaintClockSync) Reset() {
 	}
 }
 
-func (x *MaintClockSync) String() string {
+func (x *GlanceRequested) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintClockSync) ProtoMessage() {}
+func (*GlanceRequested) ProtoMessage() {}
 
-func (x *MaintClockSync) ProtoReflect() protoreflect.Message {
+func (x *GlanceRequested) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2344,29 +2674,44 @@ func (x *MaintClockSync) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintClockSync.ProtoReflect.Descriptor instead.
-func (*MaintClockSync) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceRequested.ProtoReflect.Descriptor instead.
+func (*GlanceRequested) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *MaintClockSync) GetStatus() string {
+func (x *GlanceRequested) GetMilliCPU() int64 {
 	if x != nil {
-		return x.Status
+		return x.MilliCPU
 	}
-	return ""
+	return 0
 }
 
-type MaintClockDiff struct {
+func (x *GlanceRequested) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *GlanceRequested) GetStorage() int64 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+type GlanceReserved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Time      int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	Dangerous bool  `protobuf:"varint,2,opt,name=dangerous,proto3" json:"dangerous,omitempty"`
+	MilliCPU int64 `protobuf:"varint,1,opt,name=milliCPU,proto3" json:"milliCPU,omitempty"`
+	Memory   int64 `protobuf:"varint,2,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage  int64 `protobuf:"varint,3,opt,name=storage,proto3" json:"storage,omitempty"`
 }
 
-func (x *MaintClockDiff) Reset() {
-	*x = MaintClockDiff{}
+func (x *GlanceReserved) Reset() {
+	*x = GlanceReserved{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2374,13 +2719,13 @@ func (x *MaintClockDiff) Reset() {
 	}
 }
 
-func (x *MaintClockDiff) String() string {
+func (x *GlanceReserved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaintClockDiff) ProtoMessage() {}
+func (*GlanceReserved) ProtoMessage() {}
 
-func (x *MaintClockDiff) ProtoReflect() protoreflect.Message {
+func (x *GlanceReserved) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2392,38 +2737,47 @@ func (x *MaintClockDiff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MaintClockDiff.ProtoReflect.Descriptor instead.
-func (*MaintClockDiff) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceReserved.ProtoReflect.Descriptor instead.
+func (*GlanceReserved) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *MaintClockDiff) GetTime() int64 {
+func (x *GlanceReserved) GetMilliCPU() int64 {
 	if x != nil {
-		return x.Time
+		return x.MilliCPU
 	}
 	return 0
 }
 
-func (x *MaintClockDiff) GetDangerous() bool {
+func (x *GlanceReserved) GetMemory() int64 {
 	if x != nil {
-		return x.Dangerous
+		return x.Memory
 	}
-	return false
+	return 0
 }
 
-type ConfigRequest struct {
+func (x *GlanceReserved) GetStorage() int64 {
+	if x != nil {
+		return x.Storage
+	}
+	return 0
+}
+
+type GlanceStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ApiVersion string          `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
-	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
-	Metadata   *ConfigMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Spec       *ConfigSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+	Cpu       *GlanceCPU       `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Host      string           `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Memory    *GlanceMemory    `protobuf:"bytes,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Os        string           `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	Storage   *GlanceStorage   `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	Processes []*GlanceProcess `protobuf:"bytes,6,rep,name=processes,proto3" json:"processes,omitempty"`
 }
 
-func (x *ConfigRequest) Reset() {
-	*x = ConfigRequest{}
+func (x *GlanceStats) Reset() {
+	*x = GlanceStats{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2431,13 +2785,13 @@ func (x *ConfigRequest) Reset() {
 	}
 }
 
-func (x *ConfigRequest) String() string {
+func (x *GlanceStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigRequest) ProtoMessage() {}
+func (*GlanceStats) ProtoMessage() {}
 
-func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *GlanceStats) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2449,49 +2803,64 @@ func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
-func (*ConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceStats.ProtoReflect.Descriptor instead.
+func (*GlanceStats) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *ConfigRequest) GetApiVersion() string {
+func (x *GlanceStats) GetCpu() *GlanceCPU {
 	if x != nil {
-		return x.ApiVersion
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *GlanceStats) GetHost() string {
+	if x != nil {
+		return x.Host
 	}
 	return ""
 }
 
-func (x *ConfigRequest) GetKind() string {
+func (x *GlanceStats) GetMemory() *GlanceMemory {
 	if x != nil {
-		return x.Kind
+		return x.Memory
+	}
+	return nil
+}
+
+func (x *GlanceStats) GetOs() string {
+	if x != nil {
+		return x.Os
 	}
 	return ""
 }
 
-func (x *ConfigRequest) GetMetadata() *ConfigMetadata {
+func (x *GlanceStats) GetStorage() *GlanceStorage {
 	if x != nil {
-		return x.Metadata
+		return x.Storage
 	}
 	return nil
 }
 
-func (x *ConfigRequest) GetSpec() *ConfigSpec {
+func (x *GlanceStats) GetProcesses() []*GlanceProcess {
 	if x != nil {
-		return x.Spec
+		return x.Processes
 	}
 	return nil
 }
 
-type ConfigMetadata struct {
+type GlanceCPU struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Total string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used  string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
 }
 
-func (x *ConfigMetadata) Reset() {
-	*x = ConfigMetadata{}
+func (x *GlanceCPU) Reset() {
+	*x = GlanceCPU{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2499,13 +2868,13 @@ func (x *ConfigMetadata) Reset() {
 	}
 }
 
-func (x *ConfigMetadata) String() string {
+func (x *GlanceCPU) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigMetadata) ProtoMessage() {}
+func (*GlanceCPU) ProtoMessage() {}
 
-func (x *ConfigMetadata) ProtoReflect() protoreflect.Message {
+func (x *GlanceCPU) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2517,28 +2886,36 @@ func (x *ConfigMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigMetadata.ProtoReflect.Descriptor instead.
-func (*ConfigMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceCPU.ProtoReflect.Descriptor instead.
+func (*GlanceCPU) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ConfigMetadata) GetName() string {
+func (x *GlanceCPU) GetTotal() string {
 	if x != nil {
-		return x.Name
+		return x.Total
 	}
 	return ""
 }
 
-type ConfigSpec struct {
+func (x *GlanceCPU) GetUsed() string {
+	if x != nil {
+		return x.Used
+	}
+	return ""
+}
+
+type GlanceMemory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Total string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used  string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
 }
 
-func (x *ConfigSpec) Reset() {
-	*x = ConfigSpec{}
+func (x *GlanceMemory) Reset() {
+	*x = GlanceMemory{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2546,13 +2923,13 @@ func (x *ConfigSpec) Reset() {
 	}
 }
 
-func (x *ConfigSpec) String() string {
+func (x *GlanceMemory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigSpec) ProtoMessage() {}
+func (*GlanceMemory) ProtoMessage() {}
 
-func (x *ConfigSpec) ProtoReflect() protoreflect.Message {
+func (x *GlanceMemory) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2564,28 +2941,36 @@ func (x *ConfigSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigSpec.ProtoReflect.Descriptor instead.
-func (*ConfigSpec) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceMemory.ProtoReflect.Descriptor instead.
+func (*GlanceMemory) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ConfigSpec) GetConfig() *Config {
+func (x *GlanceMemory) GetTotal() string {
 	if x != nil {
-		return x.Config
+		return x.Total
 	}
-	return nil
+	return ""
 }
 
-type Config struct {
+func (x *GlanceMemory) GetUsed() string {
+	if x != nil {
+		return x.Used
+	}
+	return ""
+}
+
+type GlanceStorage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version bool `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Total string `protobuf:"bytes,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used  string `protobuf:"bytes,2,opt,name=used,proto3" json:"used,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *GlanceStorage) Reset() {
+	*x = GlanceStorage{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2593,13 +2978,13 @@ func (x *Config) Reset() {
 	}
 }
 
-func (x *Config) String() string {
+func (x *GlanceStorage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*GlanceStorage) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *GlanceStorage) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2611,28 +2996,36 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use GlanceStorage.ProtoReflect.Descriptor instead.
+func (*GlanceStorage) Descriptor() ([]byte, []int) {
 	return file_server_proto_server_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Config) GetVersion() bool {
+func (x *GlanceStorage) GetTotal() string {
 	if x != nil {
-		return x.Version
+		return x.Total
 	}
-	return false
+	return ""
 }
 
-type ConfigReply struct {
+func (x *GlanceStorage) GetUsed() string {
+	if x != nil {
+		return x.Used
+	}
+	return ""
+}
+
+type GlanceProcess struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Process *GlanceThread   `protobuf:"bytes,1,opt,name=process,proto3" json:"process,omitempty"`
+	Threads []*GlanceThread `protobuf:"bytes,2,rep,name=threads,proto3" json:"threads,omitempty"`
 }
 
-func (x *ConfigReply) Reset() {
-	*x = ConfigReply{}
+func (x *GlanceProcess) Reset() {
+	*x = GlanceProcess{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_server_proto_server_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2640,436 +3033,3105 @@ func (x *ConfigReply) Reset() {
 	}
 }
 
-func (x *ConfigReply) String() string {
+func (x *GlanceProcess) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigReply) ProtoMessage() {}
+func (*GlanceProcess) ProtoMessage() {}
 
-func (x *ConfigReply) ProtoReflect() protoreflect.Message {
+func (x *GlanceProcess) ProtoReflect() protoreflect.Message {
 	mi := &file_server_proto_server_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ConfigReply.ProtoReflect.Descriptor instead.
-func (*ConfigReply) Descriptor() ([]byte, []int) {
-	return file_server_proto_server_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *ConfigReply) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-var File_server_proto_server_proto protoreflect.FileDescriptor
-
-var file_server_proto_server_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
-	0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x70, 0x65,
-	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
-	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22,
-	0x22, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x2c, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x12,
-	0x20, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73,
-	0x6b, 0x22, 0xdc, 0x01, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61,
-	0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x03, 0x6c,
-	0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x30,
-	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x61,
-	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
-	0x22, 0x38, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x7a, 0x69, 0x70, 0x22, 0x35, 0x0a, 0x09, 0x54, 0x61,
-	0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x1f, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05,
-	0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64,
-	0x74, 0x68, 0x22, 0x54, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61,
-	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
-	0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x08,
-	0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x22, 0x66, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b,
-	0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
-	0x22, 0x4d, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
-	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
-	0x4c, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x70, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x01,
-	0x0a, 0x0d, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
-	0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22,
-	0x24, 0x0a, 0x0e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53,
-	0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x06, 0x67, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x06,
-	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x29,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x73, 0x79, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73, 0x52, 0x65, 0x71, 0x52, 0x03, 0x73, 0x79,
-	0x73, 0x22, 0x22, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65,
-	0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a, 0x0d, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
-	0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78,
-	0x53, 0x69, 0x7a, 0x65, 0x22, 0x26, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x9e, 0x01, 0x0a,
-	0x0b, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x03,
-	0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
-	0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x70, 0x52,
-	0x03, 0x64, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12,
-	0x26, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73, 0x52,
-	0x65, 0x70, 0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a,
-	0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x70, 0x12, 0x2d, 0x0a,
-	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a,
-	0x0b, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x45, 0x0a, 0x0d,
-	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61,
-	0x62, 0x6c, 0x65, 0x22, 0x6d, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73,
-	0x52, 0x65, 0x70, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x09,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x11, 0x47, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0x5f, 0x0a, 0x0f,
-	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x6d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22, 0xea, 0x01,
-	0x0a, 0x0b, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a,
-	0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63,
-	0x70, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x06, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x6f, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x35, 0x0a, 0x09, 0x47, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x43, 0x50, 0x55, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x64, 0x22, 0x38, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x39, 0x0a, 0x0d, 0x47,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x0d, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x07,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
-	0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x52, 0x07,
-	0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x7a, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d,
-	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x74, 0x69, 0x6d,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x70, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x04, 0x73,
-	0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
-	0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70,
-	0x65, 0x63, 0x22, 0x23, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x09, 0x4d, 0x61, 0x69, 0x6e, 0x74,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x05, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69,
-	0x6e, 0x74, 0x52, 0x05, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x05, 0x4d, 0x61, 0x69,
-	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74,
-	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x22,
-	0x37, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
-	0x73, 0x79, 0x6e, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x0a, 0x4d, 0x61, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
-	0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x52, 0x05, 0x63, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x67, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x70, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
-	0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63,
-	0x12, 0x2a, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
-	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f,
-	0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x28, 0x0a, 0x0e,
-	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43,
-	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
-	0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x09, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
-	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
-	0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x24, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x70, 0x65, 0x63,
-	0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x22, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x0b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x84, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x38, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
-	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
-	0x3e, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x2e,
-	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
-	0x3b, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x2e, 0x72,
-	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e, 0x0a, 0x0a,
-	0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x72, 0x75, 0x6e,
-	0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x21, 0x5a, 0x1f,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x67,
-	0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_server_proto_server_proto_rawDescOnce sync.Once
-	file_server_proto_server_proto_rawDescData = file_server_proto_server_proto_rawDesc
-)
-
-func file_server_proto_server_proto_rawDescGZIP() []byte {
-	file_server_proto_server_proto_rawDescOnce.Do(func() {
-		file_server_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_proto_server_proto_rawDescData)
-	})
-	return file_server_proto_server_proto_rawDescData
-}
-
-var file_server_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_server_proto_server_proto_goTypes = []any{
-	(*TaskRequest)(nil),       // 0: runner.TaskRequest
-	(*TaskMetadata)(nil),      // 1: runner.TaskMetadata
-	(*TaskSpec)(nil),          // 2: runner.TaskSpec
-	(*Task)(nil),              // 3: runner.Task
-	(*TaskFile)(nil),          // 4: runner.TaskFile
-	(*TaskParam)(nil),         // 5: runner.TaskParam
-	(*TaskLog)(nil),           // 6: runner.TaskLog
-	(*TaskLanguage)(nil),      // 7: runner.TaskLanguage
-	(*TaskArtifact)(nil),      // 8: runner.TaskArtifact
-	(*TaskReply)(nil),         // 9: runner.TaskReply
-	(*TaskOutput)(nil),        // 10: runner.TaskOutput
-	(*GlanceRequest)(nil),     // 11: runner.GlanceRequest
-	(*GlanceMetadata)(nil),    // 12: runner.GlanceMetadata
-	(*GlanceSpec)(nil),        // 13: runner.GlanceSpec
-	(*Glance)(nil),            // 14: runner.Glance
-	(*GlanceDirReq)(nil),      // 15: runner.GlanceDirReq
-	(*GlanceFileReq)(nil),     // 16: runner.GlanceFileReq
-	(*GlanceSysReq)(nil),      // 17: runner.GlanceSysReq
-	(*GlanceReply)(nil),       // 18: runner.GlanceReply
-	(*GlanceDirRep)(nil),      // 19: runner.GlanceDirRep
-	(*GlanceEntry)(nil),       // 20: runner.GlanceEntry
-	(*GlanceFileRep)(nil),     // 21: runner.GlanceFileRep
-	(*GlanceSysRep)(nil),      // 22: runner.GlanceSysRep
-	(*GlanceResource)(nil),    // 23: runner.GlanceResource
-	(*GlanceAllocatable)(nil), // 24: runner.GlanceAllocatable
-	(*GlanceRequested)(nil),   // 25: runner.GlanceRequested
-	(*GlanceStats)(nil),       // 26: runner.GlanceStats
-	(*GlanceCPU)(nil),         // 27: runner.GlanceCPU
-	(*GlanceMemory)(nil),      // 28: runner.GlanceMemory
-	(*GlanceStorage)(nil),     // 29: runner.GlanceStorage
-	(*GlanceProcess)(nil),     // 30: runner.GlanceProcess
-	(*GlanceThread)(nil),      // 31: runner.GlanceThread
-	(*MaintRequest)(nil),      // 32: runner.MaintRequest
-	(*MaintMetadata)(nil),     // 33: runner.MaintMetadata
-	(*MaintSpec)(nil),         // 34: runner.MaintSpec
-	(*Maint)(nil),             // 35: runner.Maint
-	(*MaintClockReq)(nil),     // 36: runner.MaintClockReq
-	(*MaintReply)(nil),        // 37: runner.MaintReply
-	(*MaintClockRep)(nil),     // 38: runner.MaintClockRep
-	(*MaintClockSync)(nil),    // 39: runner.MaintClockSync
-	(*MaintClockDiff)(nil),    // 40: runner.MaintClockDiff
-	(*ConfigRequest)(nil),     // 41: runner.ConfigRequest
-	(*ConfigMetadata)(nil),    // 42: runner.ConfigMetadata
-	(*ConfigSpec)(nil),        // 43: runner.ConfigSpec
-	(*Config)(nil),            // 44: runner.Config
-	(*ConfigReply)(nil),       // 45: runner.ConfigReply
-}
-var file_server_proto_server_proto_depIdxs = []int32{
-	1,  // 0: runner.TaskRequest.metadata:type_name -> runner.TaskMetadata
-	2,  // 1: runner.TaskRequest.spec:type_name -> runner.TaskSpec
-	3,  // 2: runner.TaskSpec.task:type_name -> runner.Task
-	4,  // 3: runner.Task.file:type_name -> runner.TaskFile
-	5,  // 4: runner.Task.params:type_name -> runner.TaskParam
-	6,  // 5: runner.Task.log:type_name -> runner.TaskLog
-	7,  // 6: runner.Task.language:type_name -> runner.TaskLanguage
-	8,  // 7: runner.TaskLanguage.artifact:type_name -> runner.TaskArtifact
-	10, // 8: runner.TaskReply.output:type_name -> runner.TaskOutput
-	12, // 9: runner.GlanceRequest.metadata:type_name -> runner.GlanceMetadata
-	13, // 10: runner.GlanceRequest.spec:type_name -> runner.GlanceSpec
-	14, // 11: runner.GlanceSpec.glance:type_name -> runner.Glance
-	15, // 12: runner.Glance.dir:type_name -> runner.GlanceDirReq
-	16, // 13: runner.Glance.file:type_name -> runner.GlanceFileReq
-	17, // 14: runner.Glance.sys:type_name -> runner.GlanceSysReq
-	19, // 15: runner.GlanceReply.dir:type_name -> runner.GlanceDirRep
-	21, // 16: runner.GlanceReply.file:type_name -> runner.GlanceFileRep
-	22, // 17: runner.GlanceReply.sys:type_name -> runner.GlanceSysRep
-	20, // 18: runner.GlanceDirRep.entries:type_name -> runner.GlanceEntry
-	23, // 19: runner.GlanceSysRep.resource:type_name -> runner.GlanceResource
-	26, // 20: runner.GlanceSysRep.stats:type_name -> runner.GlanceStats
-	24, // 21: runner.GlanceResource.allocatable:type_name -> runner.GlanceAllocatable
-	25, // 22: runner.GlanceResource.requested:type_name -> runner.GlanceRequested
-	27, // 23: runner.GlanceStats.cpu:type_name -> runner.GlanceCPU
-	28, // 24: runner.GlanceStats.memory:type_name -> runner.GlanceMemory
-	29, // 25: runner.GlanceStats.storage:type_name -> runner.GlanceStorage
-	30, // 26: runner.GlanceStats.processes:type_name -> runner.GlanceProcess
-	31, // 27: runner.GlanceProcess.process:type_name -> runner.GlanceThread
-	31, // 28: runner.GlanceProcess.threads:type_name -> runner.GlanceThread
-	33, // 29: runner.MaintRequest.metadata:type_name -> runner.MaintMetadata
-	34, // 30: runner.MaintRequest.spec:type_name -> runner.MaintSpec
-	35, // 31: runner.MaintSpec.maint:type_name -> runner.Maint
-	36, // 32: runner.Maint.clock:type_name -> runner.MaintClockReq
-	38, // 33: runner.MaintReply.clock:type_name -> runner.MaintClockRep
-	39, // 34: runner.MaintClockRep.sync:type_name -> runner.MaintClockSync
-	40, // 35: runner.MaintClockRep.diff:type_name -> runner.MaintClockDiff
-	42, // 36: runner.ConfigRequest.metadata:type_name -> runner.ConfigMetadata
-	43, // 37: runner.ConfigRequest.spec:type_name -> runner.ConfigSpec
-	44, // 38: runner.ConfigSpec.config:type_name -> runner.Config
-	0,  // 39: runner.ServerProto.SendTask:input_type -> runner.TaskRequest
-	11, // 40: runner.ServerProto.SendGlance:input_type -> runner.GlanceRequest
-	32, // 41: runner.ServerProto.SendMaint:input_type -> runner.MaintRequest
-	41, // 42: runner.ServerProto.SendConfig:input_type -> runner.ConfigRequest
-	9,  // 43: runner.ServerProto.SendTask:output_type -> runner.TaskReply
-	18, // 44: runner.ServerProto.SendGlance:output_type -> runner.GlanceReply
-	37, // 45: runner.ServerProto.SendMaint:output_type -> runner.MaintReply
-	45, // 46: runner.ServerProto.SendConfig:output_type -> runner.ConfigReply
-	43, // [43:47] is the sub-list for method output_type
-	39, // [39:43] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
-}
-
-func init() { file_server_proto_server_proto_init() }
-func file_server_proto_server_proto_init() {
-	if File_server_proto_server_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_server_proto_server_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlanceProcess.ProtoReflect.Descriptor instead.
+func (*GlanceProcess) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GlanceProcess) GetProcess() *GlanceThread {
+	if x != nil {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *GlanceProcess) GetThreads() []*GlanceThread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+type GlanceThread struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cmdline string  `protobuf:"bytes,2,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
+	Memory  int64   `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Time    float64 `protobuf:"fixed64,4,opt,name=time,proto3" json:"time,omitempty"`
+	Pid     int64   `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
+}
+
+func (x *GlanceThread) Reset() {
+	*x = GlanceThread{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GlanceThread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlanceThread) ProtoMessage() {}
+
+func (x *GlanceThread) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlanceThread.ProtoReflect.Descriptor instead.
+func (*GlanceThread) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GlanceThread) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GlanceThread) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+func (x *GlanceThread) GetMemory() int64 {
+	if x != nil {
+		return x.Memory
+	}
+	return 0
+}
+
+func (x *GlanceThread) GetTime() float64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *GlanceThread) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+type MaintRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string         `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string         `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *MaintMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *MaintSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *MaintRequest) Reset() {
+	*x = MaintRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintRequest) ProtoMessage() {}
+
+func (x *MaintRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintRequest.ProtoReflect.Descriptor instead.
+func (*MaintRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MaintRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *MaintRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MaintRequest) GetMetadata() *MaintMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MaintRequest) GetSpec() *MaintSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type MaintMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *MaintMetadata) Reset() {
+	*x = MaintMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintMetadata) ProtoMessage() {}
+
+func (x *MaintMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintMetadata.ProtoReflect.Descriptor instead.
+func (*MaintMetadata) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MaintMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type MaintSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Maint *Maint `protobuf:"bytes,1,opt,name=maint,proto3" json:"maint,omitempty"`
+}
+
+func (x *MaintSpec) Reset() {
+	*x = MaintSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintSpec) ProtoMessage() {}
+
+func (x *MaintSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintSpec.ProtoReflect.Descriptor instead.
+func (*MaintSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MaintSpec) GetMaint() *Maint {
+	if x != nil {
+		return x.Maint
+	}
+	return nil
+}
+
+type Maint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clock *MaintClockReq `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
+}
+
+func (x *Maint) Reset() {
+	*x = Maint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maint) ProtoMessage() {}
+
+func (x *Maint) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maint.ProtoReflect.Descriptor instead.
+func (*Maint) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Maint) GetClock() *MaintClockReq {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+type MaintClockReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sync bool  `protobuf:"varint,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *MaintClockReq) Reset() {
+	*x = MaintClockReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintClockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintClockReq) ProtoMessage() {}
+
+func (x *MaintClockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintClockReq.ProtoReflect.Descriptor instead.
+func (*MaintClockReq) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MaintClockReq) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
+}
+
+func (x *MaintClockReq) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type MaintReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clock *MaintClockRep `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock,omitempty"`
+}
+
+func (x *MaintReply) Reset() {
+	*x = MaintReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintReply) ProtoMessage() {}
+
+func (x *MaintReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintReply.ProtoReflect.Descriptor instead.
+func (*MaintReply) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MaintReply) GetClock() *MaintClockRep {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+type MaintClockRep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sync *MaintClockSync `protobuf:"bytes,1,opt,name=sync,proto3" json:"sync,omitempty"`
+	Diff *MaintClockDiff `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+}
+
+func (x *MaintClockRep) Reset() {
+	*x = MaintClockRep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintClockRep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintClockRep) ProtoMessage() {}
+
+func (x *MaintClockRep) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintClockRep.ProtoReflect.Descriptor instead.
+func (*MaintClockRep) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MaintClockRep) GetSync() *MaintClockSync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+func (x *MaintClockRep) GetDiff() *MaintClockDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type MaintClockSync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *MaintClockSync) Reset() {
+	*x = MaintClockSync{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintClockSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintClockSync) ProtoMessage() {}
+
+func (x *MaintClockSync) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintClockSync.ProtoReflect.Descriptor instead.
+func (*MaintClockSync) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MaintClockSync) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type MaintClockDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time      int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Dangerous bool  `protobuf:"varint,2,opt,name=dangerous,proto3" json:"dangerous,omitempty"`
+}
+
+func (x *MaintClockDiff) Reset() {
+	*x = MaintClockDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MaintClockDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintClockDiff) ProtoMessage() {}
+
+func (x *MaintClockDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintClockDiff.ProtoReflect.Descriptor instead.
+func (*MaintClockDiff) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MaintClockDiff) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *MaintClockDiff) GetDangerous() bool {
+	if x != nil {
+		return x.Dangerous
+	}
+	return false
+}
+
+type ConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string          `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *ConfigMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *ConfigSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *ConfigRequest) Reset() {
+	*x = ConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRequest) ProtoMessage() {}
+
+func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConfigRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ConfigRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ConfigRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ConfigRequest) GetMetadata() *ConfigMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetSpec() *ConfigSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ConfigMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ConfigMetadata) Reset() {
+	*x = ConfigMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigMetadata) ProtoMessage() {}
+
+func (x *ConfigMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigMetadata.ProtoReflect.Descriptor instead.
+func (*ConfigMetadata) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ConfigMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ConfigSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config *Config `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ConfigSpec) Reset() {
+	*x = ConfigSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSpec) ProtoMessage() {}
+
+func (x *ConfigSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSpec.ProtoReflect.Descriptor instead.
+func (*ConfigSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ConfigSpec) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version bool `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Config) GetVersion() bool {
+	if x != nil {
+		return x.Version
+	}
+	return false
+}
+
+type ConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *ConfigReply) Reset() {
+	*x = ConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigReply) ProtoMessage() {}
+
+func (x *ConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigReply.ProtoReflect.Descriptor instead.
+func (*ConfigReply) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ConfigReply) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type CancelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string          `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *CancelMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *CancelSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *CancelRequest) Reset() {
+	*x = CancelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRequest) ProtoMessage() {}
+
+func (x *CancelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRequest.ProtoReflect.Descriptor instead.
+func (*CancelRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CancelRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *CancelRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CancelRequest) GetMetadata() *CancelMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CancelRequest) GetSpec() *CancelSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type CancelMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CancelMetadata) Reset() {
+	*x = CancelMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelMetadata) ProtoMessage() {}
+
+func (x *CancelMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelMetadata.ProtoReflect.Descriptor instead.
+func (*CancelMetadata) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CancelMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CancelSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cancel *Cancel `protobuf:"bytes,1,opt,name=cancel,proto3" json:"cancel,omitempty"`
+}
+
+func (x *CancelSpec) Reset() {
+	*x = CancelSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSpec) ProtoMessage() {}
+
+func (x *CancelSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSpec.ProtoReflect.Descriptor instead.
+func (*CancelSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CancelSpec) GetCancel() *Cancel {
+	if x != nil {
+		return x.Cancel
+	}
+	return nil
+}
+
+type Cancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Grace int64  `protobuf:"varint,2,opt,name=grace,proto3" json:"grace,omitempty"`
+}
+
+func (x *Cancel) Reset() {
+	*x = Cancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cancel) ProtoMessage() {}
+
+func (x *Cancel) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cancel.ProtoReflect.Descriptor instead.
+func (*Cancel) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *Cancel) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Cancel) GetGrace() int64 {
+	if x != nil {
+		return x.Grace
+	}
+	return 0
+}
+
+type CancelReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CancelReply) Reset() {
+	*x = CancelReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReply) ProtoMessage() {}
+
+func (x *CancelReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReply.ProtoReflect.Descriptor instead.
+func (*CancelReply) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CancelReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type LogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string       `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string       `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *LogMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *LogSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *LogRequest) Reset() {
+	*x = LogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogRequest) ProtoMessage() {}
+
+func (x *LogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogRequest.ProtoReflect.Descriptor instead.
+func (*LogRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *LogRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *LogRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LogRequest) GetMetadata() *LogMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LogRequest) GetSpec() *LogSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type LogMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *LogMetadata) Reset() {
+	*x = LogMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMetadata) ProtoMessage() {}
+
+func (x *LogMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMetadata.ProtoReflect.Descriptor instead.
+func (*LogMetadata) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *LogMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type LogSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Log *Log `protobuf:"bytes,1,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (x *LogSpec) Reset() {
+	*x = LogSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogSpec) ProtoMessage() {}
+
+func (x *LogSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogSpec.ProtoReflect.Descriptor instead.
+func (*LogSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *LogSpec) GetLog() *Log {
+	if x != nil {
+		return x.Log
+	}
+	return nil
+}
+
+type Log struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seq    int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Follow bool   `protobuf:"varint,3,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Log) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Log) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Log) GetFollow() bool {
+	if x != nil {
+		return x.Follow
+	}
+	return false
+}
+
+type LogReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output *TaskOutput `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	Error  string      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *LogReply) Reset() {
+	*x = LogReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogReply) ProtoMessage() {}
+
+func (x *LogReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogReply.ProtoReflect.Descriptor instead.
+func (*LogReply) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *LogReply) GetOutput() *TaskOutput {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *LogReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AttachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string          `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string          `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *AttachMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *AttachSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *AttachRequest) Reset() {
+	*x = AttachRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachRequest) ProtoMessage() {}
+
+func (x *AttachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachRequest.ProtoReflect.Descriptor instead.
+func (*AttachRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AttachRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *AttachRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *AttachRequest) GetMetadata() *AttachMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AttachRequest) GetSpec() *AttachSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type AttachMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *AttachMetadata) Reset() {
+	*x = AttachMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachMetadata) ProtoMessage() {}
+
+func (x *AttachMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachMetadata.ProtoReflect.Descriptor instead.
+func (*AttachMetadata) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *AttachMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type AttachSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attach *Attach `protobuf:"bytes,1,opt,name=attach,proto3" json:"attach,omitempty"`
+}
+
+func (x *AttachSpec) Reset() {
+	*x = AttachSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachSpec) ProtoMessage() {}
+
+func (x *AttachSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachSpec.ProtoReflect.Descriptor instead.
+func (*AttachSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AttachSpec) GetAttach() *Attach {
+	if x != nil {
+		return x.Attach
+	}
+	return nil
+}
+
+type Attach struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id  string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seq int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+}
+
+func (x *Attach) Reset() {
+	*x = Attach{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attach) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attach) ProtoMessage() {}
+
+func (x *Attach) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attach.ProtoReflect.Descriptor instead.
+func (*Attach) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Attach) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attach) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+type ImageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion string         `protobuf:"bytes,1,opt,name=apiVersion,proto3" json:"apiVersion,omitempty"`
+	Kind       string         `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Metadata   *ImageMetadata `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Spec       *ImageSpec     `protobuf:"bytes,4,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *ImageRequest) Reset() {
+	*x = ImageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageRequest) ProtoMessage() {}
+
+func (x *ImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageRequest.ProtoReflect.Descriptor instead.
+func (*ImageRequest) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ImageRequest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *ImageRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ImageRequest) GetMetadata() *ImageMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ImageRequest) GetSpec() *ImageSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type ImageMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ImageMetadata) Reset() {
+	*x = ImageMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageMetadata) ProtoMessage() {}
+
+func (x *ImageMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageMetadata.ProtoReflect.Descriptor instead.
+func (*ImageMetadata) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ImageMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ImageSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Image *Image `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *ImageSpec) Reset() {
+	*x = ImageSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageSpec) ProtoMessage() {}
+
+func (x *ImageSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageSpec.ProtoReflect.Descriptor instead.
+func (*ImageSpec) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ImageSpec) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type Image struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Evict bool `protobuf:"varint,1,opt,name=evict,proto3" json:"evict,omitempty"`
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Image) GetEvict() bool {
+	if x != nil {
+		return x.Evict
+	}
+	return false
+}
+
+type ImageReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Images  []*ImageEntry `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Size    int64         `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	MaxSize int64         `protobuf:"varint,3,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	Error   string        `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ImageReply) Reset() {
+	*x = ImageReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageReply) ProtoMessage() {}
+
+func (x *ImageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageReply.ProtoReflect.Descriptor instead.
+func (*ImageReply) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ImageReply) GetImages() []*ImageEntry {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ImageReply) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageReply) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *ImageReply) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImageEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags     []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	Size     int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	LastUsed int64    `protobuf:"varint,4,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"`
+	Pinned   int64    `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+}
+
+func (x *ImageEntry) Reset() {
+	*x = ImageEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_server_proto_server_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImageEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImageEntry) ProtoMessage() {}
+
+func (x *ImageEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_server_proto_server_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImageEntry.ProtoReflect.Descriptor instead.
+func (*ImageEntry) Descriptor() ([]byte, []int) {
+	return file_server_proto_server_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ImageEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImageEntry) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ImageEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ImageEntry) GetLastUsed() int64 {
+	if x != nil {
+		return x.LastUsed
+	}
+	return 0
+}
+
+func (x *ImageEntry) GetPinned() int64 {
+	if x != nil {
+		return x.Pinned
+	}
+	return 0
+}
+
+var File_server_proto_server_proto protoreflect.FileDescriptor
+
+var file_server_proto_server_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22,
+	0x22, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x7f, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x20, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73,
+	0x6b, 0x12, 0x27, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x64, 0x69, 0x6e, 0x52, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0xff, 0x04, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x21,
+	0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f,
+	0x67, 0x12, 0x30, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x33,
+	0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x64, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x73, 0x74, 0x64, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x79, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x74, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x63, 0x68, 0x12, 0x21, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x6e,
+	0x76, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x22, 0x3f, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x45, 0x6e,
+	0x76, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x53,
+	0x74, 0x64, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63,
+	0x6c, 0x6f, 0x73, 0x65, 0x22, 0x5c, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x22, 0x32, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x63, 0x6f, 0x6c, 0x73, 0x22, 0x38, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x67, 0x7a, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x67, 0x7a, 0x69, 0x70,
+	0x22, 0x3c, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22, 0x4d,
+	0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x73, 0x0a,
+	0x0d, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x70, 0x69, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6f, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6f, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x45, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x33, 0x0a, 0x07, 0x54, 0x61, 0x73,
+	0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6e,
+	0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x6e, 0x73, 0x69, 0x22, 0x89,
+	0x01, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x08, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x54,
+	0x61, 0x73, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x22, 0xdb, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x61, 0x6e, 0x6f, 0x43, 0x50, 0x55,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x61, 0x6e, 0x6f, 0x43, 0x50, 0x55,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6d, 0x70, 0x66,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x6d, 0x70, 0x66, 0x73, 0x52, 0x05, 0x74, 0x6d, 0x70, 0x66,
+	0x73, 0x22, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x6d, 0x70, 0x66, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x09, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x07, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x52, 0x05, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x22, 0x27, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0a, 0x54, 0x61, 0x73,
+	0x6b, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x22, 0x66, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x81, 0x01, 0x0a, 0x0b, 0x54, 0x61,
+	0x73, 0x6b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x75, 0x6d, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x73, 0x22, 0x4e, 0x0a,
+	0x0c, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0xfb, 0x02,
+	0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x6f, 0x6d, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x6f, 0x6d, 0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x61, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x61, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x70,
+	0x75, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x70, 0x75,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54,
+	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x01, 0x0a, 0x0d,
+	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x24, 0x0a,
+	0x0e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x26, 0x0a, 0x06, 0x67, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x06, 0x67, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x06, 0x47, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x04,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x03, 0x73, 0x79, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73, 0x52, 0x65, 0x71, 0x52, 0x03, 0x73, 0x79, 0x73, 0x22,
+	0x22, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a, 0x0d, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x69,
+	0x7a, 0x65, 0x22, 0x26, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x47,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x26, 0x0a, 0x03, 0x64, 0x69,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72,
+	0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x70, 0x52, 0x03, 0x64,
+	0x69, 0x72, 0x12, 0x29, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a,
+	0x03, 0x73, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73, 0x52, 0x65, 0x70,
+	0x52, 0x03, 0x73, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x3d, 0x0a, 0x0c, 0x47,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x69, 0x72, 0x52, 0x65, 0x70, 0x12, 0x2d, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x47,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69,
+	0x73, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x45, 0x0a, 0x0d, 0x47, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0xa1, 0x01, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x79, 0x73, 0x52,
+	0x65, 0x70, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x32, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0x84, 0x01, 0x0a, 0x0e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x61, 0x0a, 0x11,
+	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x22,
+	0x5f, 0x0a, 0x0f, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x22, 0x5e, 0x0a, 0x0e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x43, 0x50, 0x55, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x22, 0xea, 0x01, 0x0a, 0x0b, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x23, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x50, 0x55,
+	0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x35, 0x0a,
+	0x09, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x50, 0x55, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x0c, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x39,
+	0x0a, 0x0d, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x0d, 0x47, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x74, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x52, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x22, 0x7a, 0x0a, 0x0c, 0x47, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x70, 0x69, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25,
+	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x23, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30, 0x0a, 0x09, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x05, 0x6d, 0x61, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x6d, 0x61, 0x69, 0x6e, 0x74, 0x22, 0x34, 0x0a, 0x05,
+	0x4d, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x52, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x37, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x0a, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x52,
+	0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x67, 0x0a, 0x0d, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d,
+	0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e, 0x63, 0x52, 0x04, 0x73,
+	0x79, 0x6e, 0x63, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22,
+	0x28, 0x0a, 0x0e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x79, 0x6e,
+	0x63, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x42, 0x0a, 0x0e, 0x4d, 0x61, 0x69,
+	0x6e, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x64, 0x61, 0x6e, 0x67, 0x65, 0x72, 0x6f, 0x75, 0x73, 0x22, 0x9f, 0x01,
+	0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22,
+	0x24, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x22, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x27, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x32,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x24, 0x0a, 0x0e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x34, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26,
+	0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x06,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x22, 0x2e, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x67, 0x72, 0x61, 0x63, 0x65, 0x22, 0x23, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2f,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x23, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x22, 0x21, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x1d, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f,
+	0x67, 0x22, 0x3f, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x22, 0x4c, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a,
+	0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x9f, 0x01, 0x0a, 0x0d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x22, 0x24, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x22, 0x2a,
+	0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x9c, 0x01, 0x0a, 0x0c, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12,
+	0x31, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x23, 0x0a, 0x0d, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x30,
+	0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x23, 0x0a, 0x05, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x22, 0x1d, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x69,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x76, 0x69, 0x63, 0x74, 0x22,
+	0x7c, 0x0a, 0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a,
+	0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x78, 0x0a,
+	0x0a, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x32, 0xf9, 0x03, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x38, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e,
+	0x47, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30,
+	0x01, 0x12, 0x3b, 0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x14,
+	0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4d, 0x61,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e,
+	0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3e,
+	0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x15, 0x2e, 0x72,
+	0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3b,
+	0x0a, 0x09, 0x53, 0x65, 0x6e, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x14, 0x2e, 0x72, 0x75,
+	0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x38, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x4c, 0x6f, 0x67, 0x12, 0x12, 0x2e, 0x72, 0x75, 0x6e, 0x6e,
+	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x15, 0x2e, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x75, 0x6e,
+	0x6e, 0x65, 0x72, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x28,
+	0x01, 0x30, 0x01, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x67, 0x6f, 0x2f, 0x72, 0x75, 0x6e, 0x6e, 0x65, 0x72, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_server_proto_server_proto_rawDescOnce sync.Once
+	file_server_proto_server_proto_rawDescData = file_server_proto_server_proto_rawDesc
+)
+
+func file_server_proto_server_proto_rawDescGZIP() []byte {
+	file_server_proto_server_proto_rawDescOnce.Do(func() {
+		file_server_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_server_proto_server_proto_rawDescData)
+	})
+	return file_server_proto_server_proto_rawDescData
+}
+
+var file_server_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_server_proto_server_proto_goTypes = []any{
+	(*TaskRequest)(nil),       // 0: runner.TaskRequest
+	(*TaskMetadata)(nil),      // 1: runner.TaskMetadata
+	(*TaskSpec)(nil),          // 2: runner.TaskSpec
+	(*Task)(nil),              // 3: runner.Task
+	(*TaskEnv)(nil),           // 4: runner.TaskEnv
+	(*TaskStdin)(nil),         // 5: runner.TaskStdin
+	(*TaskRequests)(nil),      // 6: runner.TaskRequests
+	(*TaskSize)(nil),          // 7: runner.TaskSize
+	(*TaskFile)(nil),          // 8: runner.TaskFile
+	(*TaskBundle)(nil),        // 9: runner.TaskBundle
+	(*TaskParam)(nil),         // 10: runner.TaskParam
+	(*TaskResources)(nil),     // 11: runner.TaskResources
+	(*TaskArtifacts)(nil),     // 12: runner.TaskArtifacts
+	(*TaskLog)(nil),           // 13: runner.TaskLog
+	(*TaskLanguage)(nil),      // 14: runner.TaskLanguage
+	(*TaskArtifact)(nil),      // 15: runner.TaskArtifact
+	(*TaskContainer)(nil),     // 16: runner.TaskContainer
+	(*TaskTmpfs)(nil),         // 17: runner.TaskTmpfs
+	(*TaskReply)(nil),         // 18: runner.TaskReply
+	(*TaskQueue)(nil),         // 19: runner.TaskQueue
+	(*TaskOutput)(nil),        // 20: runner.TaskOutput
+	(*TaskProgress)(nil),      // 21: runner.TaskProgress
+	(*TaskArchive)(nil),       // 22: runner.TaskArchive
+	(*TaskChecksum)(nil),      // 23: runner.TaskChecksum
+	(*TaskStatus)(nil),        // 24: runner.TaskStatus
+	(*GlanceRequest)(nil),     // 25: runner.GlanceRequest
+	(*GlanceMetadata)(nil),    // 26: runner.GlanceMetadata
+	(*GlanceSpec)(nil),        // 27: runner.GlanceSpec
+	(*Glance)(nil),            // 28: runner.Glance
+	(*GlanceDirReq)(nil),      // 29: runner.GlanceDirReq
+	(*GlanceFileReq)(nil),     // 30: runner.GlanceFileReq
+	(*GlanceSysReq)(nil),      // 31: runner.GlanceSysReq
+	(*GlanceReply)(nil),       // 32: runner.GlanceReply
+	(*GlanceDirRep)(nil),      // 33: runner.GlanceDirRep
+	(*GlanceEntry)(nil),       // 34: runner.GlanceEntry
+	(*GlanceFileRep)(nil),     // 35: runner.GlanceFileRep
+	(*GlanceSysRep)(nil),      // 36: runner.GlanceSysRep
+	(*GlanceResource)(nil),    // 37: runner.GlanceResource
+	(*GlanceAllocatable)(nil), // 38: runner.GlanceAllocatable
+	(*GlanceRequested)(nil),   // 39: runner.GlanceRequested
+	(*GlanceReserved)(nil),    // 40: runner.GlanceReserved
+	(*GlanceStats)(nil),       // 41: runner.GlanceStats
+	(*GlanceCPU)(nil),         // 42: runner.GlanceCPU
+	(*GlanceMemory)(nil),      // 43: runner.GlanceMemory
+	(*GlanceStorage)(nil),     // 44: runner.GlanceStorage
+	(*GlanceProcess)(nil),     // 45: runner.GlanceProcess
+	(*GlanceThread)(nil),      // 46: runner.GlanceThread
+	(*MaintRequest)(nil),      // 47: runner.MaintRequest
+	(*MaintMetadata)(nil),     // 48: runner.MaintMetadata
+	(*MaintSpec)(nil),         // 49: runner.MaintSpec
+	(*Maint)(nil),             // 50: runner.Maint
+	(*MaintClockReq)(nil),     // 51: runner.MaintClockReq
+	(*MaintReply)(nil),        // 52: runner.MaintReply
+	(*MaintClockRep)(nil),     // 53: runner.MaintClockRep
+	(*MaintClockSync)(nil),    // 54: runner.MaintClockSync
+	(*MaintClockDiff)(nil),    // 55: runner.MaintClockDiff
+	(*ConfigRequest)(nil),     // 56: runner.ConfigRequest
+	(*ConfigMetadata)(nil),    // 57: runner.ConfigMetadata
+	(*ConfigSpec)(nil),        // 58: runner.ConfigSpec
+	(*Config)(nil),            // 59: runner.Config
+	(*ConfigReply)(nil),       // 60: runner.ConfigReply
+	(*CancelRequest)(nil),     // 61: runner.CancelRequest
+	(*CancelMetadata)(nil),    // 62: runner.CancelMetadata
+	(*CancelSpec)(nil),        // 63: runner.CancelSpec
+	(*Cancel)(nil),            // 64: runner.Cancel
+	(*CancelReply)(nil),       // 65: runner.CancelReply
+	(*LogRequest)(nil),        // 66: runner.LogRequest
+	(*LogMetadata)(nil),       // 67: runner.LogMetadata
+	(*LogSpec)(nil),           // 68: runner.LogSpec
+	(*Log)(nil),               // 69: runner.Log
+	(*LogReply)(nil),          // 70: runner.LogReply
+	(*AttachRequest)(nil),     // 71: runner.AttachRequest
+	(*AttachMetadata)(nil),    // 72: runner.AttachMetadata
+	(*AttachSpec)(nil),        // 73: runner.AttachSpec
+	(*Attach)(nil),            // 74: runner.Attach
+	(*ImageRequest)(nil),      // 75: runner.ImageRequest
+	(*ImageMetadata)(nil),     // 76: runner.ImageMetadata
+	(*ImageSpec)(nil),         // 77: runner.ImageSpec
+	(*Image)(nil),             // 78: runner.Image
+	(*ImageReply)(nil),        // 79: runner.ImageReply
+	(*ImageEntry)(nil),        // 80: runner.ImageEntry
+	nil,                       // 81: runner.TaskStatus.OutputsEntry
+}
+var file_server_proto_server_proto_depIdxs = []int32{
+	1,  // 0: runner.TaskRequest.metadata:type_name -> runner.TaskMetadata
+	2,  // 1: runner.TaskRequest.spec:type_name -> runner.TaskSpec
+	3,  // 2: runner.TaskSpec.task:type_name -> runner.Task
+	5,  // 3: runner.TaskSpec.stdin:type_name -> runner.TaskStdin
+	7,  // 4: runner.TaskSpec.resize:type_name -> runner.TaskSize
+	8,  // 5: runner.Task.file:type_name -> runner.TaskFile
+	10, // 6: runner.Task.params:type_name -> runner.TaskParam
+	13, // 7: runner.Task.log:type_name -> runner.TaskLog
+	14, // 8: runner.Task.language:type_name -> runner.TaskLanguage
+	11, // 9: runner.Task.resources:type_name -> runner.TaskResources
+	12, // 10: runner.Task.artifacts:type_name -> runner.TaskArtifacts
+	9,  // 11: runner.Task.bundle:type_name -> runner.TaskBundle
+	7,  // 12: runner.Task.size:type_name -> runner.TaskSize
+	6,  // 13: runner.Task.requests:type_name -> runner.TaskRequests
+	4,  // 14: runner.Task.env:type_name -> runner.TaskEnv
+	15, // 15: runner.TaskLanguage.artifact:type_name -> runner.TaskArtifact
+	16, // 16: runner.TaskLanguage.container:type_name -> runner.TaskContainer
+	10, // 17: runner.TaskContainer.env:type_name -> runner.TaskParam
+	17, // 18: runner.TaskContainer.tmpfs:type_name -> runner.TaskTmpfs
+	20, // 19: runner.TaskReply.output:type_name -> runner.TaskOutput
+	24, // 20: runner.TaskReply.status:type_name -> runner.TaskStatus
+	22, // 21: runner.TaskReply.archive:type_name -> runner.TaskArchive
+	21, // 22: runner.TaskReply.progress:type_name -> runner.TaskProgress
+	19, // 23: runner.TaskReply.queue:type_name -> runner.TaskQueue
+	23, // 24: runner.TaskArchive.checksums:type_name -> runner.TaskChecksum
+	81, // 25: runner.TaskStatus.outputs:type_name -> runner.TaskStatus.OutputsEntry
+	26, // 26: runner.GlanceRequest.metadata:type_name -> runner.GlanceMetadata
+	27, // 27: runner.GlanceRequest.spec:type_name -> runner.GlanceSpec
+	28, // 28: runner.GlanceSpec.glance:type_name -> runner.Glance
+	29, // 29: runner.Glance.dir:type_name -> runner.GlanceDirReq
+	30, // 30: runner.Glance.file:type_name -> runner.GlanceFileReq
+	31, // 31: runner.Glance.sys:type_name -> runner.GlanceSysReq
+	33, // 32: runner.GlanceReply.dir:type_name -> runner.GlanceDirRep
+	35, // 33: runner.GlanceReply.file:type_name -> runner.GlanceFileRep
+	36, // 34: runner.GlanceReply.sys:type_name -> runner.GlanceSysRep
+	34, // 35: runner.GlanceDirRep.entries:type_name -> runner.GlanceEntry
+	37, // 36: runner.GlanceSysRep.resource:type_name -> runner.GlanceResource
+	41, // 37: runner.GlanceSysRep.stats:type_name -> runner.GlanceStats
+	40, // 38: runner.GlanceSysRep.reserved:type_name -> runner.GlanceReserved
+	38, // 39: runner.GlanceResource.allocatable:type_name -> runner.GlanceAllocatable
+	39, // 40: runner.GlanceResource.requested:type_name -> runner.GlanceRequested
+	42, // 41: runner.GlanceStats.cpu:type_name -> runner.GlanceCPU
+	43, // 42: runner.GlanceStats.memory:type_name -> runner.GlanceMemory
+	44, // 43: runner.GlanceStats.storage:type_name -> runner.GlanceStorage
+	45, // 44: runner.GlanceStats.processes:type_name -> runner.GlanceProcess
+	46, // 45: runner.GlanceProcess.process:type_name -> runner.GlanceThread
+	46, // 46: runner.GlanceProcess.threads:type_name -> runner.GlanceThread
+	48, // 47: runner.MaintRequest.metadata:type_name -> runner.MaintMetadata
+	49, // 48: runner.MaintRequest.spec:type_name -> runner.MaintSpec
+	50, // 49: runner.MaintSpec.maint:type_name -> runner.Maint
+	51, // 50: runner.Maint.clock:type_name -> runner.MaintClockReq
+	53, // 51: runner.MaintReply.clock:type_name -> runner.MaintClockRep
+	54, // 52: runner.MaintClockRep.sync:type_name -> runner.MaintClockSync
+	55, // 53: runner.MaintClockRep.diff:type_name -> runner.MaintClockDiff
+	57, // 54: runner.ConfigRequest.metadata:type_name -> runner.ConfigMetadata
+	58, // 55: runner.ConfigRequest.spec:type_name -> runner.ConfigSpec
+	59, // 56: runner.ConfigSpec.config:type_name -> runner.Config
+	62, // 57: runner.CancelRequest.metadata:type_name -> runner.CancelMetadata
+	63, // 58: runner.CancelRequest.spec:type_name -> runner.CancelSpec
+	64, // 59: runner.CancelSpec.cancel:type_name -> runner.Cancel
+	67, // 60: runner.LogRequest.metadata:type_name -> runner.LogMetadata
+	68, // 61: runner.LogRequest.spec:type_name -> runner.LogSpec
+	69, // 62: runner.LogSpec.log:type_name -> runner.Log
+	20, // 63: runner.LogReply.output:type_name -> runner.TaskOutput
+	72, // 64: runner.AttachRequest.metadata:type_name -> runner.AttachMetadata
+	73, // 65: runner.AttachRequest.spec:type_name -> runner.AttachSpec
+	74, // 66: runner.AttachSpec.attach:type_name -> runner.Attach
+	76, // 67: runner.ImageRequest.metadata:type_name -> runner.ImageMetadata
+	77, // 68: runner.ImageRequest.spec:type_name -> runner.ImageSpec
+	78, // 69: runner.ImageSpec.image:type_name -> runner.Image
+	80, // 70: runner.ImageReply.images:type_name -> runner.ImageEntry
+	0,  // 71: runner.ServerProto.SendTask:input_type -> runner.TaskRequest
+	25, // 72: runner.ServerProto.SendGlance:input_type -> runner.GlanceRequest
+	47, // 73: runner.ServerProto.SendMaint:input_type -> runner.MaintRequest
+	56, // 74: runner.ServerProto.SendConfig:input_type -> runner.ConfigRequest
+	61, // 75: runner.ServerProto.CancelTask:input_type -> runner.CancelRequest
+	75, // 76: runner.ServerProto.SendImage:input_type -> runner.ImageRequest
+	66, // 77: runner.ServerProto.GetTaskLog:input_type -> runner.LogRequest
+	71, // 78: runner.ServerProto.AttachTask:input_type -> runner.AttachRequest
+	18, // 79: runner.ServerProto.SendTask:output_type -> runner.TaskReply
+	32, // 80: runner.ServerProto.SendGlance:output_type -> runner.GlanceReply
+	52, // 81: runner.ServerProto.SendMaint:output_type -> runner.MaintReply
+	60, // 82: runner.ServerProto.SendConfig:output_type -> runner.ConfigReply
+	65, // 83: runner.ServerProto.CancelTask:output_type -> runner.CancelReply
+	79, // 84: runner.ServerProto.SendImage:output_type -> runner.ImageReply
+	70, // 85: runner.ServerProto.GetTaskLog:output_type -> runner.LogReply
+	18, // 86: runner.ServerProto.AttachTask:output_type -> runner.TaskReply
+	79, // [79:87] is the sub-list for method output_type
+	71, // [71:79] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_server_proto_server_proto_init() }
+func file_server_proto_server_proto_init() {
+	if File_server_proto_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_server_proto_server_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Task); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskEnv); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskStdin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskRequests); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskSize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskParam); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskResources); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskArtifacts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskLanguage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskArtifact); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskTmpfs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskQueue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskArchive); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskChecksum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*TaskStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*Glance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceDirReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceFileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceSysReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3080,8 +6142,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskMetadata); i {
+		file_server_proto_server_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3092,8 +6154,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskSpec); i {
+		file_server_proto_server_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceDirRep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3104,8 +6166,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Task); i {
+		file_server_proto_server_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3116,8 +6178,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskFile); i {
+		file_server_proto_server_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceFileRep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3128,8 +6190,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskParam); i {
+		file_server_proto_server_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceSysRep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3140,8 +6202,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskLog); i {
+		file_server_proto_server_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceResource); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3152,8 +6214,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskLanguage); i {
+		file_server_proto_server_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceAllocatable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3164,8 +6226,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskArtifact); i {
+		file_server_proto_server_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceRequested); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3176,8 +6238,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskReply); i {
+		file_server_proto_server_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceReserved); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3188,8 +6250,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*TaskOutput); i {
+		file_server_proto_server_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,8 +6262,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceRequest); i {
+		file_server_proto_server_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceCPU); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,8 +6274,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceMetadata); i {
+		file_server_proto_server_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceMemory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,8 +6286,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceSpec); i {
+		file_server_proto_server_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceStorage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,8 +6298,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*Glance); i {
+		file_server_proto_server_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceProcess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,8 +6310,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceDirReq); i {
+		file_server_proto_server_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GlanceThread); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,8 +6322,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceFileReq); i {
+		file_server_proto_server_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,8 +6334,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceSysReq); i {
+		file_server_proto_server_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3284,8 +6346,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceReply); i {
+		file_server_proto_server_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3296,8 +6358,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceDirRep); i {
+		file_server_proto_server_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*Maint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3308,8 +6370,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceEntry); i {
+		file_server_proto_server_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintClockReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3320,8 +6382,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceFileRep); i {
+		file_server_proto_server_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3332,8 +6394,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceSysRep); i {
+		file_server_proto_server_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintClockRep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3344,8 +6406,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceResource); i {
+		file_server_proto_server_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintClockSync); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3356,8 +6418,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceAllocatable); i {
+		file_server_proto_server_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*MaintClockDiff); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3368,8 +6430,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceRequested); i {
+		file_server_proto_server_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3380,8 +6442,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceStats); i {
+		file_server_proto_server_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3392,8 +6454,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceCPU); i {
+		file_server_proto_server_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3404,8 +6466,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceMemory); i {
+		file_server_proto_server_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3416,8 +6478,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceStorage); i {
+		file_server_proto_server_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfigReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3428,8 +6490,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceProcess); i {
+		file_server_proto_server_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3440,8 +6502,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*GlanceThread); i {
+		file_server_proto_server_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3452,8 +6514,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintRequest); i {
+		file_server_proto_server_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3464,8 +6526,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintMetadata); i {
+		file_server_proto_server_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*Cancel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3476,8 +6538,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintSpec); i {
+		file_server_proto_server_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3488,8 +6550,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*Maint); i {
+		file_server_proto_server_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*LogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3500,8 +6562,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintClockReq); i {
+		file_server_proto_server_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*LogMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3512,8 +6574,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintReply); i {
+		file_server_proto_server_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*LogSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3524,8 +6586,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintClockRep); i {
+		file_server_proto_server_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3536,8 +6598,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintClockSync); i {
+		file_server_proto_server_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*LogReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3548,8 +6610,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*MaintClockDiff); i {
+		file_server_proto_server_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3560,8 +6622,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfigRequest); i {
+		file_server_proto_server_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3572,8 +6634,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfigMetadata); i {
+		file_server_proto_server_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*AttachSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3584,8 +6646,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfigSpec); i {
+		file_server_proto_server_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*Attach); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3596,8 +6658,8 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*Config); i {
+		file_server_proto_server_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3608,8 +6670,56 @@ func file_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_server_proto_server_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*ConfigReply); i {
+		file_server_proto_server_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*Image); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_server_proto_server_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*ImageEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3627,7 +6737,7 @@ func file_server_proto_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_server_proto_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,10 @@ const (
 	ServerProto_SendGlance_FullMethodName = "/runner.ServerProto/SendGlance"
 	ServerProto_SendMaint_FullMethodName  = "/runner.ServerProto/SendMaint"
 	ServerProto_SendConfig_FullMethodName = "/runner.ServerProto/SendConfig"
+	ServerProto_CancelTask_FullMethodName = "/runner.ServerProto/CancelTask"
+	ServerProto_SendImage_FullMethodName  = "/runner.ServerProto/SendImage"
+	ServerProto_GetTaskLog_FullMethodName = "/runner.ServerProto/GetTaskLog"
+	ServerProto_AttachTask_FullMethodName = "/runner.ServerProto/AttachTask"
 )
 
 // ServerProtoClient is the client API for ServerProto service.
@@ -33,6 +37,10 @@ type ServerProtoClient interface {
 	SendGlance(ctx context.Context, opts ...grpc.CallOption) (ServerProto_SendGlanceClient, error)
 	SendMaint(ctx context.Context, opts ...grpc.CallOption) (ServerProto_SendMaintClient, error)
 	SendConfig(ctx context.Context, opts ...grpc.CallOption) (ServerProto_SendConfigClient, error)
+	CancelTask(ctx context.Context, opts ...grpc.CallOption) (ServerProto_CancelTaskClient, error)
+	SendImage(ctx context.Context, opts ...grpc.CallOption) (ServerProto_SendImageClient, error)
+	GetTaskLog(ctx context.Context, opts ...grpc.CallOption) (ServerProto_GetTaskLogClient, error)
+	AttachTask(ctx context.Context, opts ...grpc.CallOption) (ServerProto_AttachTaskClient, error)
 }
 
 type serverProtoClient struct {
@@ -171,6 +179,134 @@ func (x *serverProtoSendConfigClient) Recv() (*ConfigReply, error) {
 	return m, nil
 }
 
+func (c *serverProtoClient) CancelTask(ctx context.Context, opts ...grpc.CallOption) (ServerProto_CancelTaskClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServerProto_ServiceDesc.Streams[4], ServerProto_CancelTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverProtoCancelTaskClient{ClientStream: stream}
+	return x, nil
+}
+
+type ServerProto_CancelTaskClient interface {
+	Send(*CancelRequest) error
+	Recv() (*CancelReply, error)
+	grpc.ClientStream
+}
+
+type serverProtoCancelTaskClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverProtoCancelTaskClient) Send(m *CancelRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverProtoCancelTaskClient) Recv() (*CancelReply, error) {
+	m := new(CancelReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverProtoClient) SendImage(ctx context.Context, opts ...grpc.CallOption) (ServerProto_SendImageClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServerProto_ServiceDesc.Streams[5], ServerProto_SendImage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverProtoSendImageClient{ClientStream: stream}
+	return x, nil
+}
+
+type ServerProto_SendImageClient interface {
+	Send(*ImageRequest) error
+	Recv() (*ImageReply, error)
+	grpc.ClientStream
+}
+
+type serverProtoSendImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverProtoSendImageClient) Send(m *ImageRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverProtoSendImageClient) Recv() (*ImageReply, error) {
+	m := new(ImageReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverProtoClient) GetTaskLog(ctx context.Context, opts ...grpc.CallOption) (ServerProto_GetTaskLogClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServerProto_ServiceDesc.Streams[6], ServerProto_GetTaskLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverProtoGetTaskLogClient{ClientStream: stream}
+	return x, nil
+}
+
+type ServerProto_GetTaskLogClient interface {
+	Send(*LogRequest) error
+	Recv() (*LogReply, error)
+	grpc.ClientStream
+}
+
+type serverProtoGetTaskLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverProtoGetTaskLogClient) Send(m *LogRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverProtoGetTaskLogClient) Recv() (*LogReply, error) {
+	m := new(LogReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *serverProtoClient) AttachTask(ctx context.Context, opts ...grpc.CallOption) (ServerProto_AttachTaskClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServerProto_ServiceDesc.Streams[7], ServerProto_AttachTask_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &serverProtoAttachTaskClient{ClientStream: stream}
+	return x, nil
+}
+
+type ServerProto_AttachTaskClient interface {
+	Send(*AttachRequest) error
+	Recv() (*TaskReply, error)
+	grpc.ClientStream
+}
+
+type serverProtoAttachTaskClient struct {
+	grpc.ClientStream
+}
+
+func (x *serverProtoAttachTaskClient) Send(m *AttachRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *serverProtoAttachTaskClient) Recv() (*TaskReply, error) {
+	m := new(TaskReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServerProtoServer is the server API for ServerProto service.
 // All implementations must embed UnimplementedServerProtoServer
 // for forward compatibility
@@ -179,6 +315,10 @@ type ServerProtoServer interface {
 	SendGlance(ServerProto_SendGlanceServer) error
 	SendMaint(ServerProto_SendMaintServer) error
 	SendConfig(ServerProto_SendConfigServer) error
+	CancelTask(ServerProto_CancelTaskServer) error
+	SendImage(ServerProto_SendImageServer) error
+	GetTaskLog(ServerProto_GetTaskLogServer) error
+	AttachTask(ServerProto_AttachTaskServer) error
 	mustEmbedUnimplementedServerProtoServer()
 }
 
@@ -198,6 +338,18 @@ func (UnimplementedServerProtoServer) SendMaint(ServerProto_SendMaintServer) err
 func (UnimplementedServerProtoServer) SendConfig(ServerProto_SendConfigServer) error {
 	return status.Errorf(codes.Unimplemented, "method SendConfig not implemented")
 }
+func (UnimplementedServerProtoServer) CancelTask(ServerProto_CancelTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (UnimplementedServerProtoServer) SendImage(ServerProto_SendImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method SendImage not implemented")
+}
+func (UnimplementedServerProtoServer) GetTaskLog(ServerProto_GetTaskLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTaskLog not implemented")
+}
+func (UnimplementedServerProtoServer) AttachTask(ServerProto_AttachTaskServer) error {
+	return status.Errorf(codes.Unimplemented, "method AttachTask not implemented")
+}
 func (UnimplementedServerProtoServer) mustEmbedUnimplementedServerProtoServer() {}
 
 // UnsafeServerProtoServer may be embedded to opt out of forward compatibility for this service.
@@ -315,6 +467,110 @@ func (x *serverProtoSendConfigServer) Recv() (*ConfigRequest, error) {
 	return m, nil
 }
 
+func _ServerProto_CancelTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerProtoServer).CancelTask(&serverProtoCancelTaskServer{ServerStream: stream})
+}
+
+type ServerProto_CancelTaskServer interface {
+	Send(*CancelReply) error
+	Recv() (*CancelRequest, error)
+	grpc.ServerStream
+}
+
+type serverProtoCancelTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverProtoCancelTaskServer) Send(m *CancelReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serverProtoCancelTaskServer) Recv() (*CancelRequest, error) {
+	m := new(CancelRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ServerProto_SendImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerProtoServer).SendImage(&serverProtoSendImageServer{ServerStream: stream})
+}
+
+type ServerProto_SendImageServer interface {
+	Send(*ImageReply) error
+	Recv() (*ImageRequest, error)
+	grpc.ServerStream
+}
+
+type serverProtoSendImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverProtoSendImageServer) Send(m *ImageReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serverProtoSendImageServer) Recv() (*ImageRequest, error) {
+	m := new(ImageRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ServerProto_GetTaskLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerProtoServer).GetTaskLog(&serverProtoGetTaskLogServer{ServerStream: stream})
+}
+
+type ServerProto_GetTaskLogServer interface {
+	Send(*LogReply) error
+	Recv() (*LogRequest, error)
+	grpc.ServerStream
+}
+
+type serverProtoGetTaskLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverProtoGetTaskLogServer) Send(m *LogReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serverProtoGetTaskLogServer) Recv() (*LogRequest, error) {
+	m := new(LogRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ServerProto_AttachTask_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ServerProtoServer).AttachTask(&serverProtoAttachTaskServer{ServerStream: stream})
+}
+
+type ServerProto_AttachTaskServer interface {
+	Send(*TaskReply) error
+	Recv() (*AttachRequest, error)
+	grpc.ServerStream
+}
+
+type serverProtoAttachTaskServer struct {
+	grpc.ServerStream
+}
+
+func (x *serverProtoAttachTaskServer) Send(m *TaskReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *serverProtoAttachTaskServer) Recv() (*AttachRequest, error) {
+	m := new(AttachRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ServerProto_ServiceDesc is the grpc.ServiceDesc for ServerProto service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +603,30 @@ var ServerProto_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "CancelTask",
+			Handler:       _ServerProto_CancelTask_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "SendImage",
+			Handler:       _ServerProto_SendImage_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetTaskLog",
+			Handler:       _ServerProto_GetTaskLog_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AttachTask",
+			Handler:       _ServerProto_AttachTask_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "server/proto/server.proto",
 }
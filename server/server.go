@@ -2,35 +2,45 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"io"
 	"math"
 	"net"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/pipego/runner/config"
 	fl "github.com/pipego/runner/file"
 	"github.com/pipego/runner/glance"
 	"github.com/pipego/runner/maint"
+	"github.com/pipego/runner/queue"
 	pb "github.com/pipego/runner/server/proto"
+	"github.com/pipego/runner/store"
 	"github.com/pipego/runner/task"
+	"github.com/pipego/runner/workspace"
 )
 
 const (
-	EOF    = "EOF" // end of file
-	Kind   = "runner"
-	Layout = "20060102150405"
+	EOF   = "EOF" // end of file
+	IdLen = 16
+	Kind  = "runner"
 )
 
 const (
-	Prefix = "pipego-runner-file-"
-	Tmp    = "/tmp"
+	ArchiveChunk = 1024 * 1024
+	ArchiveSize  = 64 * 1024 * 1024
+	BundleSize   = 256 * 1024 * 1024
+	Script       = "pipego-runner-file"
 )
 
 type Server interface {
@@ -41,11 +51,19 @@ type Server interface {
 
 type Config struct {
 	Addr   string
+	Config config.Config
 	Logger hclog.Logger
 }
 
 type server struct {
-	cfg *Config
+	cfg       *Config
+	mu        sync.RWMutex
+	tasks     map[string]task.Task
+	queued    map[string]context.CancelFunc
+	workspace workspace.Workspace
+	cache     task.Cache
+	queue     queue.Queue
+	store     store.Store
 	pb.UnimplementedServerProtoServer
 }
 
@@ -60,10 +78,64 @@ func DefaultConfig() *Config {
 }
 
 func (s *server) Init(ctx context.Context) error {
+	var err error
+
+	s.workspace, err = s.newWorkspace(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to new workspace")
+	}
+
+	if err := s.workspace.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init workspace")
+	}
+
+	s.cache, err = s.newCache(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to new cache")
+	}
+
+	if err := s.cache.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init cache")
+	}
+
+	s.queue, err = s.newQueue(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to new queue")
+	}
+
+	if err := s.queue.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init queue")
+	}
+
+	s.store, err = s.newStore(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to new store")
+	}
+
+	if err := s.store.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init store")
+	}
+
 	return nil
 }
 
 func (s *server) Deinit(ctx context.Context) error {
+	if s.workspace != nil {
+		_ = s.workspace.Deinit(ctx)
+	}
+
+	if s.cache != nil {
+		_ = s.cache.Deinit(ctx)
+	}
+
+	if s.queue != nil {
+		_ = s.queue.Deinit(ctx)
+	}
+
+	if s.store != nil {
+		_ = s.store.Deinit(ctx)
+	}
+
 	return nil
 }
 
@@ -83,21 +155,131 @@ func (s *server) SendTask(srv pb.ServerProto_SendTaskServer) error {
 	var path string
 
 	// Receive task
-	name, file, params, commands, width, language, err := s.recvTask(srv)
+	spec, err := s.recvTask(srv)
 	if err != nil {
 		s.cfg.Logger.Error("SendTask", err.Error())
 		return srv.Send(&pb.TaskReply{Error: err.Error()})
 	}
 
+	file := spec.GetFile()
+	bundle := spec.GetBundle()
+	commands := spec.GetCommands()
+
 	if len(file.GetContent()) != 0 && len(commands) != 0 {
 		err := "file and commands not supported meanwhile"
 		s.cfg.Logger.Error("SendTask", err)
 		return srv.Send(&pb.TaskReply{Error: err})
 	}
 
-	ctx, cancel := context.WithCancel(srv.Context())
+	if bundle.GetEntry() != "" && (len(file.GetContent()) != 0 || len(commands) != 0) {
+		err := "entry and file or commands not supported meanwhile"
+		s.cfg.Logger.Error("SendTask", err)
+		return srv.Send(&pb.TaskReply{Error: err})
+	}
+
+	// Task survives disconnect of client in detached mode
+	parent := srv.Context()
+	if spec.GetDetach() {
+		parent = context.WithoutCancel(parent)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
 
+	// Build env
+	id := s.newId()
+
+	environ, err := s.buildEnviron(ctx, spec.GetEnv())
+	if err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	// Runner env is referenced in params only if selected in policy, and all is referenced if no policy is set
+	vars := s.buildVars(ctx, id, spec.GetName())
+	referenced := environ
+	if referenced == nil {
+		referenced = os.Environ()
+	}
+
+	env, secrets, err := s.buildEnv(ctx, spec.GetParams(), referenced, vars)
+	if err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	env = append(vars, env...)
+
+	containerEnv, containerSecrets, err := s.buildEnv(ctx, spec.GetLanguage().GetContainer().GetEnv(), referenced, vars)
+	if err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	// Register task, which is cancellable in queue
+	t, err := s.newTask(ctx)
+	if err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	if err := t.Ansi(ctx, spec.GetLog().GetAnsi()); err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	waitCtx, waitCancel := context.WithCancel(ctx)
+	defer waitCancel()
+
+	s.addTask(id, t, waitCancel)
+	defer s.delTask(id)
+
+	if err := s.store.Open(ctx, id); err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	defer func(ctx context.Context, id string) {
+		_ = s.store.Close(ctx, id)
+	}(ctx, id)
+
+	if err := srv.Send(&pb.TaskReply{Id: id}); err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return err
+	}
+
+	// Wait for slot
+	requests := s.buildRequests(ctx, spec.GetRequests())
+
+	if err := s.acquireTask(waitCtx, srv, spec.GetPriority(), requests); err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		if errors.Is(err, queue.ErrFull) || errors.Is(err, queue.ErrExceeded) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		if ctx.Err() == nil {
+			// Task is cancelled in queue before running
+			return s.sendStatus(ctx, srv, id, &task.Status{Reason: task.ReasonCancelled})
+		}
+		return status.FromContextError(err).Err()
+	}
+
+	defer s.queue.Release(ctx, requests)
+
+	s.dequeueTask(id)
+
+	// Init workspace
+	failed := true
+
+	dir, err := s.workspace.Create(ctx, id)
+	if err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	defer func(ctx context.Context, dir string) {
+		_ = s.workspace.Release(ctx, dir, failed)
+	}(ctx, dir)
+
 	// Init file
 	f, err := s.newFile(ctx)
 	if err != nil {
@@ -114,9 +296,18 @@ func (s *server) SendTask(srv pb.ServerProto_SendTaskServer) error {
 		_ = f.Deinit(ctx)
 	}(ctx)
 
+	// Load bundle
+	if len(bundle.GetContent()) != 0 {
+		path, err = s.loadBundle(ctx, f, dir, bundle.GetContent(), bundle.GetEntry())
+		if err != nil {
+			s.cfg.Logger.Error("SendTask", err.Error())
+			return srv.Send(&pb.TaskReply{Error: err.Error()})
+		}
+	}
+
 	// Parse commands
 	if len(file.GetContent()) != 0 {
-		path, err = s.loadFile(ctx, f, file.GetContent(), file.GetGzip())
+		path, err = s.loadFile(ctx, f, dir, file.GetContent(), file.GetGzip())
 		defer func(ctx context.Context, path string) {
 			_ = f.Remove(ctx, path)
 		}(ctx, path)
@@ -127,26 +318,65 @@ func (s *server) SendTask(srv pb.ServerProto_SendTaskServer) error {
 	}
 
 	// Run task
-	t, err := s.newTask(ctx)
-	if err != nil {
+	width := int(spec.GetLog().GetWidth())
+	limit := s.buildLimit(ctx, spec.GetTimeout(), spec.GetDeadline(), spec.GetResources())
+
+	progress := make(chan struct{})
+
+	go func(p <-chan task.Progress) {
+		s.sendProgress(srv, p)
+		close(progress)
+	}(t.Progress(ctx))
+
+	err = t.Init(ctx, width, s.buildLanguage(ctx, spec.GetLanguage(), containerEnv), limit)
+	<-progress
+
+	// Executor is cleaned up even if aborted in preparing
+	defer func(ctx context.Context) {
+		_ = t.Deinit(ctx)
+	}(ctx)
+
+	if errors.Is(err, task.ErrCancelled) {
+		return s.sendStatus(ctx, srv, id, &task.Status{Reason: task.ReasonCancelled})
+	} else if err != nil {
 		s.cfg.Logger.Error("SendTask", err.Error())
 		return srv.Send(&pb.TaskReply{Error: err.Error()})
 	}
 
-	if err := t.Init(ctx, width, s.buildLanguage(ctx, language)); err != nil {
-		s.cfg.Logger.Error("SendTask", err.Error())
-		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	var stdin io.WriteCloser
+
+	if spec.GetStdin() {
+		if stdin, err = t.Stdin(ctx); err != nil {
+			s.cfg.Logger.Error("SendTask", err.Error())
+			return srv.Send(&pb.TaskReply{Error: err.Error()})
+		}
 	}
 
-	defer func(ctx context.Context) {
-		_ = t.Deinit(ctx)
-	}(ctx)
+	if spec.GetTty() {
+		if err := t.Terminal(ctx, uint16(spec.GetSize().GetRows()), uint16(spec.GetSize().GetCols())); err != nil {
+			s.cfg.Logger.Error("SendTask", err.Error())
+			return srv.Send(&pb.TaskReply{Error: err.Error()})
+		}
+	}
 
-	if err := t.Run(ctx, name, s.buildEnv(ctx, params), commands, path); err != nil {
+	t.Secret(ctx, append(secrets, containerSecrets...))
+	t.Environ(ctx, environ)
+
+	if err := t.Run(ctx, spec.GetName(), dir, env, commands, path); err != nil {
+		if stdin != nil {
+			_ = stdin.Close()
+		}
+		if errors.Is(err, task.ErrCancelled) {
+			return s.sendStatus(ctx, srv, id, &task.Status{Reason: task.ReasonCancelled})
+		}
 		s.cfg.Logger.Error("SendTask", err.Error())
 		return srv.Send(&pb.TaskReply{Error: err.Error()})
 	}
 
+	if stdin != nil || spec.GetTty() {
+		go s.recvInput(ctx, srv, t, stdin, spec.GetTty())
+	}
+
 	log := t.Tail(ctx)
 
 L:
@@ -157,11 +387,16 @@ L:
 		case line, ok := <-log.Line.Out:
 			if ok {
 				s.cfg.Logger.Debug("SendTask: line", line)
+				if err := s.store.Write(ctx, id, line); err != nil {
+					s.cfg.Logger.Warn("SendTask", err.Error())
+				}
 				_ = srv.Send(&pb.TaskReply{
 					Output: &pb.TaskOutput{
+						Seq:     line.Seq,
 						Pos:     line.Pos,
 						Time:    line.Time,
 						Message: line.Message,
+						Stream:  line.Stream,
 					}})
 				if line.Message == EOF {
 					break L
@@ -170,12 +405,194 @@ L:
 		}
 	}
 
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	status := t.Wait(ctx)
+	s.cfg.Logger.Debug("SendTask: status", status)
+
+	if status.Outputs, err = t.Output(ctx); err != nil {
+		s.cfg.Logger.Error("SendTask", err.Error())
+		_ = srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	failed = status.Reason != task.ReasonExited || status.ExitCode != 0
+
+	if len(spec.GetArtifacts().GetPatterns()) != 0 {
+		if err := s.sendArchive(ctx, srv, f, dir, spec.GetArtifacts()); err != nil {
+			s.cfg.Logger.Error("SendTask", err.Error())
+			_ = srv.Send(&pb.TaskReply{Error: err.Error()})
+		}
+	}
+
+	return s.sendStatus(ctx, srv, id, &status)
+}
+
+func (s *server) CancelTask(srv pb.ServerProto_CancelTaskServer) error {
+	id, grace, err := s.recvCancel(srv)
+	if err != nil {
+		s.cfg.Logger.Error("CancelTask", err.Error())
+		return srv.Send(&pb.CancelReply{Error: err.Error()})
+	}
+
+	t, ok := s.getTask(id)
+	if !ok {
+		err := "invalid id"
+		s.cfg.Logger.Error("CancelTask", err)
+		return srv.Send(&pb.CancelReply{Error: err})
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	s.cfg.Logger.Debug("CancelTask: id", id)
+
+	if err := t.Cancel(ctx, s.buildGrace(ctx, grace)); err != nil {
+		s.cfg.Logger.Error("CancelTask", err.Error())
+		return srv.Send(&pb.CancelReply{Error: err.Error()})
+	}
+
+	// Task waiting in queue is removed from queue
+	s.cancelQueued(id)
+
+	return srv.Send(&pb.CancelReply{})
+}
+
+func (s *server) AttachTask(srv pb.ServerProto_AttachTaskServer) error {
+	id, seq, err := s.recvAttach(srv)
+	if err != nil {
+		s.cfg.Logger.Error("AttachTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	if s.store == nil {
+		err := "invalid store"
+		s.cfg.Logger.Error("AttachTask", err)
+		return srv.Send(&pb.TaskReply{Error: err})
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	s.cfg.Logger.Debug("AttachTask: id", id)
+
+	err = s.store.Read(ctx, id, seq, true, func(line *task.Line) error {
+		return srv.Send(&pb.TaskReply{
+			Output: &pb.TaskOutput{
+				Seq:     line.Seq,
+				Pos:     line.Pos,
+				Time:    line.Time,
+				Message: line.Message,
+				Stream:  line.Stream,
+			}})
+	})
+
+	if err != nil {
+		s.cfg.Logger.Error("AttachTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	status, err := s.store.ReadStatus(ctx, id)
+	if err != nil {
+		s.cfg.Logger.Error("AttachTask", err.Error())
+		return srv.Send(&pb.TaskReply{Error: err.Error()})
+	}
+
+	return srv.Send(&pb.TaskReply{
+		Status: s.buildStatus(ctx, &status),
+	})
+}
+
+func (s *server) GetTaskLog(srv pb.ServerProto_GetTaskLogServer) error {
+	id, seq, follow, err := s.recvLog(srv)
+	if err != nil {
+		s.cfg.Logger.Error("GetTaskLog", err.Error())
+		return srv.Send(&pb.LogReply{Error: err.Error()})
+	}
+
+	if s.store == nil {
+		err := "invalid store"
+		s.cfg.Logger.Error("GetTaskLog", err)
+		return srv.Send(&pb.LogReply{Error: err})
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	s.cfg.Logger.Debug("GetTaskLog: id", id)
+
+	err = s.store.Read(ctx, id, seq, follow, func(line *task.Line) error {
+		return srv.Send(&pb.LogReply{
+			Output: &pb.TaskOutput{
+				Seq:     line.Seq,
+				Pos:     line.Pos,
+				Time:    line.Time,
+				Message: line.Message,
+				Stream:  line.Stream,
+			}})
+	})
+
+	if err != nil {
+		s.cfg.Logger.Error("GetTaskLog", err.Error())
+		return srv.Send(&pb.LogReply{Error: err.Error()})
+	}
+
 	return nil
 }
 
+func (s *server) SendImage(srv pb.ServerProto_SendImageServer) error {
+	evict, err := s.recvImage(srv)
+	if err != nil {
+		s.cfg.Logger.Error("SendImage", err.Error())
+		return srv.Send(&pb.ImageReply{Error: err.Error()})
+	}
+
+	if s.cache == nil {
+		err := "invalid cache"
+		s.cfg.Logger.Error("SendImage", err)
+		return srv.Send(&pb.ImageReply{Error: err})
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	s.cfg.Logger.Debug("SendImage: evict", evict)
+
+	if evict {
+		if err := s.cache.Evict(ctx); err != nil {
+			s.cfg.Logger.Error("SendImage", err.Error())
+			return srv.Send(&pb.ImageReply{Error: err.Error()})
+		}
+	}
+
+	images, err := s.cache.Inventory(ctx)
+	if err != nil {
+		s.cfg.Logger.Error("SendImage", err.Error())
+		return srv.Send(&pb.ImageReply{Error: err.Error()})
+	}
+
+	reply := &pb.ImageReply{
+		MaxSize: s.cfg.Config.Spec.Cache.MaxSize,
+	}
+
+	for _, item := range images {
+		reply.Images = append(reply.Images, &pb.ImageEntry{
+			Id:       item.ID,
+			Tags:     item.Tags,
+			Size:     item.Size,
+			LastUsed: item.LastUsed,
+			Pinned:   item.Pinned,
+		})
+		reply.Size += item.Size
+	}
+
+	return srv.Send(reply)
+}
+
 // nolint:funlen
 func (s *server) SendGlance(srv pb.ServerProto_SendGlanceServer) error {
-	var allocatable, requested glance.Resource
+	var allocatable, requested, reserved glance.Resource
 	var content, _host, _os string
 	var entBuf []*pb.GlanceEntry
 	var entries []glance.Entry
@@ -241,6 +658,9 @@ func (s *server) SendGlance(srv pb.ServerProto_SendGlanceServer) error {
 			s.cfg.Logger.Error("SendGlance", err.Error())
 			return srv.Send(&pb.GlanceReply{Error: err.Error()})
 		}
+		if s.queue != nil {
+			reserved = s.queue.Reserved(ctx)
+		}
 		helper := func(data []glance.Thread) []*pb.GlanceThread {
 			var threads []*pb.GlanceThread
 			for _, item := range data {
@@ -273,6 +693,7 @@ func (s *server) SendGlance(srv pb.ServerProto_SendGlanceServer) error {
 	s.cfg.Logger.Debug("SendGlance: readable", readable)
 	s.cfg.Logger.Debug("SendGlance: allocatable", allocatable)
 	s.cfg.Logger.Debug("SendGlance: requested", requested)
+	s.cfg.Logger.Debug("SendGlance: reserved", reserved)
 	s.cfg.Logger.Debug("SendGlance: cpu", _cpu)
 	s.cfg.Logger.Debug("SendGlance: memory", _memory)
 	s.cfg.Logger.Debug("SendGlance: storage", _storage)
@@ -316,6 +737,11 @@ func (s *server) SendGlance(srv pb.ServerProto_SendGlanceServer) error {
 				},
 				Processes: procBuf,
 			},
+			Reserved: &pb.GlanceReserved{
+				MilliCPU: reserved.MilliCPU,
+				Memory:   reserved.Memory,
+				Storage:  reserved.Storage,
+			},
 		}})
 
 	return nil
@@ -373,33 +799,104 @@ func (s *server) SendConfig(srv pb.ServerProto_SendConfigServer) error {
 	return nil
 }
 
-// nolint:gocritic
-func (s *server) recvTask(srv pb.ServerProto_SendTaskServer) (name string, file *pb.TaskFile, params []*pb.TaskParam,
-	commands []string, width int, language *pb.TaskLanguage, err error) {
+func (s *server) recvTask(srv pb.ServerProto_SendTaskServer) (spec *pb.Task, err error) {
 	for {
 		r, err := srv.Recv()
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			return "", nil, nil, nil, 0, nil, errors.Wrap(err, "failed to receive")
+			return nil, errors.Wrap(err, "failed to receive")
 		}
 
 		if r.Kind != Kind {
-			return "", nil, nil, nil, 0, nil, errors.New("invalid kind")
+			return nil, errors.New("invalid kind")
 		}
 
-		name = r.Spec.Task.GetName()
-		file = r.Spec.Task.GetFile()
-		params = r.Spec.Task.GetParams()
-		commands = r.Spec.Task.GetCommands()
-		width = int(r.Spec.Task.GetLog().GetWidth())
-		language = r.Spec.Task.GetLanguage()
+		spec = r.Spec.GetTask()
 
 		break
 	}
 
-	return name, file, params, commands, width, language, nil
+	return spec, nil
+}
+
+// acquireTask waits for slot and resource of task in queue, and sends the position in queue until acquired
+func (s *server) acquireTask(ctx context.Context, srv pb.ServerProto_SendTaskServer, priority int64,
+	requests glance.Resource) error {
+	return s.queue.Acquire(ctx, priority, requests, func(pos int64) {
+		_ = srv.Send(&pb.TaskReply{Queue: &pb.TaskQueue{Position: pos}})
+	})
+}
+
+// sendStatus saves the final status of task for attach, and sends it in the last reply
+func (s *server) sendStatus(ctx context.Context, srv pb.ServerProto_SendTaskServer, id string, status *task.Status) error {
+	if err := s.store.WriteStatus(ctx, id, status); err != nil {
+		s.cfg.Logger.Warn("SendTask", err.Error())
+	}
+
+	_ = srv.Send(&pb.TaskReply{
+		Status: s.buildStatus(ctx, status),
+	})
+
+	return nil
+}
+
+// sendProgress sends the progress of task until closed
+func (s *server) sendProgress(srv pb.ServerProto_SendTaskServer, progress <-chan task.Progress) {
+	for item := range progress {
+		_ = srv.Send(&pb.TaskReply{
+			Progress: &pb.TaskProgress{
+				Id:      item.ID,
+				Status:  item.Status,
+				Current: item.Current,
+				Total:   item.Total,
+			},
+		})
+	}
+}
+
+// recvInput writes the stdin in the following requests until closed, and resizes terminal of tty until the stream ends
+func (s *server) recvInput(ctx context.Context, srv pb.ServerProto_SendTaskServer, t task.Task, stdin io.WriteCloser,
+	tty bool) {
+	closeStdin := func() {
+		if stdin != nil {
+			_ = stdin.Close()
+			stdin = nil
+		}
+	}
+
+	defer closeStdin()
+
+	for stdin != nil || tty {
+		r, err := srv.Recv()
+		if err != nil {
+			return
+		}
+
+		if r.Kind != Kind {
+			s.cfg.Logger.Error("SendTask", "invalid kind")
+			continue
+		}
+
+		if in := r.Spec.GetStdin(); in != nil && stdin != nil {
+			if len(in.GetContent()) != 0 {
+				if _, err := stdin.Write(in.GetContent()); err != nil {
+					s.cfg.Logger.Debug("SendTask: stdin", err.Error())
+					closeStdin()
+				}
+			}
+			if in.GetClose() {
+				closeStdin()
+			}
+		}
+
+		if size := r.Spec.GetResize(); size != nil && tty {
+			if err := t.Resize(ctx, uint16(size.GetRows()), uint16(size.GetCols())); err != nil {
+				s.cfg.Logger.Debug("SendTask: resize", err.Error())
+			}
+		}
+	}
 }
 
 func (s *server) newFile(ctx context.Context) (fl.File, error) {
@@ -413,7 +910,7 @@ func (s *server) newFile(ctx context.Context) (fl.File, error) {
 	return fl.New(ctx, c), nil
 }
 
-func (s *server) loadFile(ctx context.Context, file fl.File, data []byte, gzip bool) (string, error) {
+func (s *server) loadFile(ctx context.Context, file fl.File, dir string, data []byte, gzip bool) (string, error) {
 	var buf []byte
 	var err error
 
@@ -426,7 +923,7 @@ func (s *server) loadFile(ctx context.Context, file fl.File, data []byte, gzip b
 		buf = data
 	}
 
-	name := filepath.Join(string(os.PathSeparator), Tmp, Prefix+time.Now().Format(Layout))
+	name := filepath.Join(dir, Script)
 
 	if err = file.Write(ctx, name, buf); err != nil {
 		_ = file.Remove(ctx, name)
@@ -441,55 +938,345 @@ func (s *server) loadFile(ctx context.Context, file fl.File, data []byte, gzip b
 	return name, nil
 }
 
+// loadBundle extracts the bundle into dir, and returns the path of entry in bundle if any
+func (s *server) loadBundle(ctx context.Context, file fl.File, dir string, data []byte, entry string) (string, error) {
+	if err := file.Untar(ctx, dir, data, BundleSize); err != nil {
+		return "", errors.Wrap(err, "failed to untar")
+	}
+
+	if entry == "" {
+		return "", nil
+	}
+
+	name := filepath.Clean(filepath.FromSlash(entry))
+	if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(os.PathSeparator)) {
+		return "", errors.New("invalid entry")
+	}
+
+	name = filepath.Join(dir, name)
+
+	if info, err := os.Lstat(name); err != nil || !info.Mode().IsRegular() {
+		return "", errors.New("entry not found")
+	}
+
+	return name, nil
+}
+
+// sendArchive streams the artifacts in dir as tar.gz in chunks, and the checksums of files are sent in the last one
+func (s *server) sendArchive(ctx context.Context, srv pb.ServerProto_SendTaskServer, file fl.File, dir string,
+	artifacts *pb.TaskArtifacts) error {
+	size := int64(ArchiveSize)
+	if artifacts.GetMaxSize() > 0 && artifacts.GetMaxSize() < size {
+		size = artifacts.GetMaxSize()
+	}
+
+	buf, checksums, err := file.Tar(ctx, dir, artifacts.GetPatterns(), size)
+	if err != nil {
+		return errors.Wrap(err, "failed to tar")
+	}
+
+	buf, err = file.Zip(ctx, buf)
+	if err != nil {
+		return errors.Wrap(err, "failed to zip")
+	}
+
+	var sums []*pb.TaskChecksum
+
+	for _, item := range checksums {
+		sums = append(sums, &pb.TaskChecksum{
+			Name:   item.Name,
+			Size:   item.Size,
+			Sha256: item.Sha256,
+		})
+	}
+
+	for pos := int64(1); ; pos++ {
+		n := min(len(buf), ArchiveChunk)
+		archive := &pb.TaskArchive{
+			Pos:     pos,
+			Content: buf[:n],
+			Last:    n == len(buf),
+		}
+		if archive.Last {
+			archive.Checksums = sums
+		}
+		if err := srv.Send(&pb.TaskReply{Archive: archive}); err != nil {
+			return errors.Wrap(err, "failed to send")
+		}
+		if archive.Last {
+			break
+		}
+		buf = buf[n:]
+	}
+
+	return nil
+}
+
+func (s *server) newWorkspace(ctx context.Context) (workspace.Workspace, error) {
+	c := workspace.DefaultConfig()
+	if c == nil {
+		return nil, errors.New("failed to config")
+	}
+
+	c.Config = s.cfg.Config
+	c.Logger = s.cfg.Logger
+
+	return workspace.New(ctx, c), nil
+}
+
 func (s *server) newTask(ctx context.Context) (task.Task, error) {
 	c := task.DefaultConfig()
 	if c == nil {
 		return nil, errors.New("failed to config")
 	}
 
+	c.Config = s.cfg.Config
 	c.Logger = s.cfg.Logger
+	c.Cache = s.cache
 
 	return task.New(ctx, c), nil
 }
 
-func (s *server) buildEnv(ctx context.Context, params []*pb.TaskParam) []string {
+func (s *server) newQueue(ctx context.Context) (queue.Queue, error) {
+	c := queue.DefaultConfig()
+	if c == nil {
+		return nil, errors.New("failed to config")
+	}
+
+	g, err := s.newGlance(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to new glance")
+	}
+
+	allocatable, err := g.Allocatable(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get allocatable")
+	}
+
+	c.Config = s.cfg.Config
+	c.Logger = s.cfg.Logger
+	c.Allocatable = allocatable
+
+	return queue.New(ctx, c), nil
+}
+
+func (s *server) newStore(ctx context.Context) (store.Store, error) {
+	c := store.DefaultConfig()
+	if c == nil {
+		return nil, errors.New("failed to config")
+	}
+
+	c.Config = s.cfg.Config
+	c.Logger = s.cfg.Logger
+
+	return store.New(ctx, c), nil
+}
+
+func (s *server) newCache(ctx context.Context) (task.Cache, error) {
+	c := task.DefaultConfig()
+	if c == nil {
+		return nil, errors.New("failed to config")
+	}
+
+	c.Config = s.cfg.Config
+	c.Logger = s.cfg.Logger
+
+	return task.NewCache(ctx, c), nil
+}
+
+func (s *server) newId() string {
+	buf := make([]byte, IdLen)
+	_, _ = rand.Read(buf)
+
+	return hex.EncodeToString(buf)
+}
+
+// addTask registers task in id, and queued cancels the wait of task in queue until the task is dequeued
+func (s *server) addTask(id string, t task.Task, queued context.CancelFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.tasks == nil {
+		s.tasks = map[string]task.Task{}
+	}
+
+	if s.queued == nil {
+		s.queued = map[string]context.CancelFunc{}
+	}
+
+	s.tasks[id] = t
+	s.queued[id] = queued
+}
+
+func (s *server) delTask(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tasks, id)
+	delete(s.queued, id)
+}
+
+func (s *server) dequeueTask(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.queued, id)
+}
+
+func (s *server) cancelQueued(id string) {
+	s.mu.RLock()
+	cancel, ok := s.queued[id]
+	s.mu.RUnlock()
+
+	if ok {
+		cancel()
+	}
+}
+
+func (s *server) getTask(id string) (task.Task, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	t, ok := s.tasks[id]
+
+	return t, ok
+}
+
+// buildEnviron returns the variables of runner selected in policy of runner and then task, and nil is returned
+// if neither policy is set
+func (s *server) buildEnviron(_ context.Context, env *pb.TaskEnv) ([]string, error) {
+	policy := s.cfg.Config.Spec.Task.Env
+
+	if policy.Policy == "" && env.GetPolicy() == "" {
+		return nil, nil
+	}
+
+	buf, err := filterEnv(os.Environ(), policy.Policy, policy.Allowlist)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter runner env")
+	}
+
+	buf, err = filterEnv(buf, env.GetPolicy(), env.GetAllowlist())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to filter task env")
+	}
+
+	return buf, nil
+}
+
+// buildVars returns the default variables in config and the variables injected by runner
+func (s *server) buildVars(_ context.Context, id, name string) []string {
 	var buf []string
 
-	for _, item := range params {
-		buf = append(buf, item.GetName()+"="+s.evalEnv(ctx, params, item.GetValue()))
+	for _, item := range s.cfg.Config.Spec.Task.Env.Defaults {
+		buf = append(buf, item.Name+"="+item.Value)
 	}
 
-	return buf
+	runner := s.cfg.Config.MetaData.Name
+	if runner == "" {
+		runner, _ = os.Hostname()
+	}
+
+	return append(buf, EnvRunnerId+"="+runner, EnvTaskId+"="+id, EnvTaskName+"="+name)
 }
 
-func (s *server) evalEnv(ctx context.Context, params []*pb.TaskParam, data string) string {
-	if strings.HasPrefix(data, "$") {
-		if strings.HasPrefix(data, "$$") {
-			return data
+// buildEnv returns the params expanded in env, and the values of secret params.
+// The variables in vars are referenced in params, and the latter overrides the former in the same name.
+func (s *server) buildEnv(_ context.Context, params []*pb.TaskParam, vars ...[]string) (env, secrets []string,
+	err error) {
+	buf := map[string]string{}
+
+	for _, item := range vars {
+		for _, v := range item {
+			name, val, _ := strings.Cut(v, "=")
+			buf[name] = val
 		}
+	}
 
-		for _, item := range params {
-			if item.GetName() == strings.TrimPrefix(data, "$") {
-				return s.evalEnv(ctx, params, item.GetValue())
-			}
+	e := newExpander(params, buf)
+
+	for _, item := range params {
+		val, err := e.expand(item.GetName(), item.GetValue())
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to expand "+item.GetName())
+		}
+		env = append(env, item.GetName()+"="+val)
+		if item.GetSecret() {
+			secrets = append(secrets, val)
 		}
 	}
 
-	return data
+	return env, secrets, nil
 }
 
-func (s *server) buildLanguage(_ context.Context, language *pb.TaskLanguage) task.Language {
+func (s *server) buildLanguage(_ context.Context, language *pb.TaskLanguage, env []string) task.Language {
+	var tmpfs []task.Tmpfs
+
+	for _, item := range language.GetContainer().GetTmpfs() {
+		tmpfs = append(tmpfs, task.Tmpfs{
+			Target: item.GetTarget(),
+			Size:   item.GetSize(),
+		})
+	}
+
 	return task.Language{
 		Name: language.GetName(),
 		Artifact: task.Artifact{
-			Image:   language.GetArtifact().GetImage(),
-			User:    language.GetArtifact().GetUser(),
-			Pass:    language.GetArtifact().GetPass(),
-			Cleanup: language.GetArtifact().GetCleanup(),
+			Image:      language.GetArtifact().GetImage(),
+			User:       language.GetArtifact().GetUser(),
+			Pass:       language.GetArtifact().GetPass(),
+			Cleanup:    language.GetArtifact().GetCleanup(),
+			PullPolicy: language.GetArtifact().GetPullPolicy(),
+			Credential: language.GetArtifact().GetCredential(),
+		},
+		Container: task.Container{
+			NanoCPUs: language.GetContainer().GetNanoCPUs(),
+			Memory:   language.GetContainer().GetMemory(),
+			Network:  language.GetContainer().GetNetwork(),
+			User:     language.GetContainer().GetUser(),
+			ReadOnly: language.GetContainer().GetReadOnly(),
+			Env:      env,
+			Tmpfs:    tmpfs,
 		},
 	}
 }
 
+func (s *server) buildLimit(_ context.Context, timeout, deadline int64, resources *pb.TaskResources) task.Limit {
+	limit := task.Limit{
+		Resources: task.Resources{
+			MilliCPU: resources.GetMilliCPU(),
+			Memory:   resources.GetMemory(),
+			Pids:     resources.GetPids(),
+			IOWeight: resources.GetIoWeight(),
+		},
+	}
+
+	if timeout > 0 {
+		limit.Timeout = time.Duration(timeout) * time.Second
+	}
+
+	if deadline > 0 {
+		limit.Deadline = time.Unix(deadline, 0)
+	}
+
+	return limit
+}
+
+func (s *server) buildStatus(_ context.Context, status *task.Status) *pb.TaskStatus {
+	return &pb.TaskStatus{
+		ExitCode:   status.ExitCode,
+		Signal:     status.Signal,
+		OomKilled:  status.OOMKilled,
+		Reason:     status.Reason,
+		StartTime:  status.StartTime,
+		EndTime:    status.EndTime,
+		Duration:   status.Duration,
+		MemoryPeak: status.MemoryPeak,
+		CpuTime:    status.CPUTime,
+		Outputs:    status.Outputs,
+	}
+}
+
 // nolint:lll
 func (s *server) recvGlance(srv pb.ServerProto_SendGlanceServer) (dir *pb.GlanceDirReq, file *pb.GlanceFileReq, sys *pb.GlanceSysReq, err error) {
 	for {
@@ -580,3 +1367,115 @@ func (s *server) recvConfig(srv pb.ServerProto_SendConfigServer) (version bool,
 
 	return version, nil
 }
+
+func (s *server) recvCancel(srv pb.ServerProto_CancelTaskServer) (id string, grace int64, err error) {
+	for {
+		r, err := srv.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", 0, errors.Wrap(err, "failed to receive")
+		}
+
+		if r.Kind != Kind {
+			return "", 0, errors.New("invalid kind")
+		}
+
+		id = r.Spec.Cancel.GetId()
+		grace = r.Spec.Cancel.GetGrace()
+
+		break
+	}
+
+	return id, grace, nil
+}
+
+func (s *server) recvAttach(srv pb.ServerProto_AttachTaskServer) (id string, seq int64, err error) {
+	for {
+		r, err := srv.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", 0, errors.Wrap(err, "failed to receive")
+		}
+
+		if r.Kind != Kind {
+			return "", 0, errors.New("invalid kind")
+		}
+
+		id = r.GetSpec().GetAttach().GetId()
+		seq = r.GetSpec().GetAttach().GetSeq()
+
+		break
+	}
+
+	return id, seq, nil
+}
+
+func (s *server) recvLog(srv pb.ServerProto_GetTaskLogServer) (id string, seq int64, follow bool, err error) {
+	for {
+		r, err := srv.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return "", 0, false, errors.Wrap(err, "failed to receive")
+		}
+
+		if r.Kind != Kind {
+			return "", 0, false, errors.New("invalid kind")
+		}
+
+		id = r.GetSpec().GetLog().GetId()
+		seq = r.GetSpec().GetLog().GetSeq()
+		follow = r.GetSpec().GetLog().GetFollow()
+
+		break
+	}
+
+	return id, seq, follow, nil
+}
+
+func (s *server) recvImage(srv pb.ServerProto_SendImageServer) (evict bool, err error) {
+	for {
+		r, err := srv.Recv()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return false, errors.Wrap(err, "failed to receive")
+		}
+
+		if r.Kind != Kind {
+			return false, errors.New("invalid kind")
+		}
+
+		evict = r.GetSpec().GetImage().GetEvict()
+
+		break
+	}
+
+	return evict, nil
+}
+
+func (s *server) buildRequests(_ context.Context, requests *pb.TaskRequests) glance.Resource {
+	return glance.Resource{
+		MilliCPU: requests.GetMilliCPU(),
+		Memory:   requests.GetMemory(),
+		Storage:  requests.GetStorage(),
+	}
+}
+
+func (s *server) buildGrace(_ context.Context, grace int64) time.Duration {
+	if grace > 0 {
+		return time.Duration(grace) * time.Second
+	}
+
+	if s.cfg.Config.Spec.Task.Grace > 0 {
+		return s.cfg.Config.Spec.Task.Grace
+	}
+
+	return task.Grace
+}
@@ -1,16 +1,28 @@
 package server
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
+	"io"
+	"math"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/pipego/runner/config"
+	"github.com/pipego/runner/glance"
 	pb "github.com/pipego/runner/server/proto"
+	"github.com/pipego/runner/task"
 )
 
 func initZip(data []byte) ([]byte, error) {
@@ -32,6 +44,42 @@ func initZip(data []byte) ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+type sendTaskServer struct {
+	grpc.ServerStream
+	requests []*pb.TaskRequest
+	replies  []*pb.TaskReply
+}
+
+func (s *sendTaskServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *sendTaskServer) Send(reply *pb.TaskReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func (s *sendTaskServer) Recv() (*pb.TaskRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return r, nil
+}
+
+type stdinBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *stdinBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
 func TestInitTask(t *testing.T) {
 	s := server{
 		cfg: DefaultConfig(),
@@ -59,6 +107,21 @@ func TestInitMaint(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestInitWorkspace(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+
+	err := s.Init(context.Background())
+	assert.Equal(t, nil, err)
+
+	err = s.Deinit(context.Background())
+	assert.Equal(t, nil, err)
+}
+
 func TestLoadUnzipped(t *testing.T) {
 	s := server{
 		cfg: DefaultConfig(),
@@ -69,8 +132,10 @@ func TestLoadUnzipped(t *testing.T) {
 	f, err := s.newFile(ctx)
 	assert.Equal(t, nil, err)
 
+	dir := t.TempDir()
+
 	buf := []byte("#!/bin/bash\necho \"Hello World!\"")
-	name, err := s.loadFile(ctx, f, buf, false)
+	name, err := s.loadFile(ctx, f, dir, buf, false)
 	assert.Equal(t, nil, err)
 
 	if _, err = os.Stat(name); errors.Is(err, os.ErrNotExist) {
@@ -80,7 +145,7 @@ func TestLoadUnzipped(t *testing.T) {
 	_ = f.Remove(ctx, name)
 
 	buf = []byte("echo \"Hello World!\"")
-	_, err = s.loadFile(ctx, f, buf, false)
+	_, err = s.loadFile(ctx, f, dir, buf, false)
 	assert.NotEqual(t, nil, err)
 }
 
@@ -94,11 +159,13 @@ func TestLoadZipped(t *testing.T) {
 	f, err := s.newFile(ctx)
 	assert.Equal(t, nil, err)
 
+	dir := t.TempDir()
+
 	buf := []byte("#!/bin/bash\necho \"Hello World!\"")
 	buf, err = initZip(buf)
 	assert.Equal(t, nil, err)
 
-	name, err := s.loadFile(ctx, f, buf, true)
+	name, err := s.loadFile(ctx, f, dir, buf, true)
 	assert.Equal(t, nil, err)
 
 	if _, err = os.Stat(name); errors.Is(err, os.ErrNotExist) {
@@ -111,7 +178,7 @@ func TestLoadZipped(t *testing.T) {
 	buf, err = initZip(buf)
 	assert.Equal(t, nil, err)
 
-	name, err = s.loadFile(ctx, f, buf, true)
+	name, err = s.loadFile(ctx, f, dir, buf, true)
 	assert.NotEqual(t, nil, err)
 
 	_ = f.Remove(ctx, name)
@@ -124,23 +191,33 @@ func TestBuildEnv(t *testing.T) {
 		cfg: DefaultConfig(),
 	}
 
+	s.cfg.Config.MetaData.Name = "runner"
+	s.cfg.Config.Spec.Task.Env.Defaults = []config.Variable{{Name: "default1", Value: "value0"}}
+
 	ctx := context.Background()
 
-	buf := s.buildEnv(ctx, params)
-	assert.Equal(t, 0, len(buf))
+	vars := s.buildVars(ctx, "id", "name")
+	assert.Equal(t, []string{"default1=value0", EnvRunnerId + "=runner", EnvTaskId + "=id", EnvTaskName + "=name"}, vars)
+
+	env, secrets, err := s.buildEnv(ctx, params, vars)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(env))
+	assert.Equal(t, 0, len(secrets))
 
 	params = append(params,
 		&pb.TaskParam{
-			Name:  "name1",
-			Value: "value1",
+			Name:   "name1",
+			Value:  "value1",
+			Secret: true,
 		},
 		&pb.TaskParam{
 			Name:  "name2",
 			Value: "$name1",
 		},
 		&pb.TaskParam{
-			Name:  "name3",
-			Value: "$name2",
+			Name:   "name3",
+			Value:  "${name2}-${" + EnvTaskId + "}",
+			Secret: true,
 		},
 		&pb.TaskParam{
 			Name:  "name4",
@@ -150,15 +227,73 @@ func TestBuildEnv(t *testing.T) {
 			Name:  "name5",
 			Value: "#name1",
 		},
+		&pb.TaskParam{
+			Name:  "PATH",
+			Value: "$PATH:/opt/bin:$default1",
+		},
 	)
 
-	buf = s.buildEnv(ctx, params)
-	assert.NotEqual(t, 0, len(buf))
-	assert.Equal(t, "name1=value1", buf[0])
-	assert.Equal(t, "name2=value1", buf[1])
-	assert.Equal(t, "name3=value1", buf[2])
-	assert.Equal(t, "name4=$$name1", buf[3])
-	assert.Equal(t, "name5=#name1", buf[4])
+	env, secrets, err = s.buildEnv(ctx, params, []string{"PATH=/bin"}, vars)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"name1=value1", "name2=value1", "name3=value1-id", "name4=$name1", "name5=#name1",
+		"PATH=/bin:/opt/bin:value0"}, env)
+	assert.Equal(t, []string{"value1", "value1-id"}, secrets)
+
+	params = append(params,
+		&pb.TaskParam{
+			Name:  "name6",
+			Value: "$name7",
+		},
+		&pb.TaskParam{
+			Name:  "name7",
+			Value: "${name6}",
+		},
+	)
+
+	_, _, err = s.buildEnv(ctx, params, vars)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestBuildEnviron(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	t.Setenv("PIPEGO_TEST_NAME1", "value1")
+	t.Setenv("PIPEGO_TEST_NAME2", "value2")
+
+	environ, err := s.buildEnviron(ctx, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string(nil), environ)
+
+	environ, err = s.buildEnviron(ctx, &pb.TaskEnv{Policy: PolicyInherit})
+	assert.Equal(t, nil, err)
+	assert.Contains(t, environ, "PIPEGO_TEST_NAME1=value1")
+
+	s.cfg.Config.Spec.Task.Env.Policy = PolicyAllowlist
+	s.cfg.Config.Spec.Task.Env.Allowlist = []string{"PIPEGO_TEST_*"}
+
+	environ, err = s.buildEnviron(ctx, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"PIPEGO_TEST_NAME1=value1", "PIPEGO_TEST_NAME2=value2"}, environ)
+
+	// Task policy never selects the variables out of runner policy
+	environ, err = s.buildEnviron(ctx, &pb.TaskEnv{Policy: PolicyAllowlist, Allowlist: []string{"PIPEGO_TEST_NAME2", "HOME"}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"PIPEGO_TEST_NAME2=value2"}, environ)
+
+	environ, err = s.buildEnviron(ctx, &pb.TaskEnv{Policy: PolicyInherit})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"PIPEGO_TEST_NAME1=value1", "PIPEGO_TEST_NAME2=value2"}, environ)
+
+	environ, err = s.buildEnviron(ctx, &pb.TaskEnv{Policy: PolicyClean})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{}, environ)
+
+	_, err = s.buildEnviron(ctx, &pb.TaskEnv{Policy: "invalid"})
+	assert.NotEqual(t, nil, err)
 }
 
 func TestBuildLanguage(t *testing.T) {
@@ -176,13 +311,1080 @@ func TestBuildLanguage(t *testing.T) {
 			Pass:    "pass",
 			Cleanup: false,
 		},
+		Container: &pb.TaskContainer{
+			NanoCPUs: 1000000000,
+			Memory:   1073741824,
+			Network:  "none",
+			User:     "1000:1000",
+			ReadOnly: true,
+			Tmpfs: []*pb.TaskTmpfs{
+				{
+					Target: "/tmp",
+					Size:   67108864,
+				},
+			},
+		},
 	}
 
-	buf := s.buildLanguage(ctx, lang)
+	buf := s.buildLanguage(ctx, lang, []string{"name1=value1"})
 	assert.NotEqual(t, nil, buf)
 	assert.Equal(t, "name", buf.Name)
 	assert.Equal(t, "image", buf.Artifact.Image)
 	assert.Equal(t, "name", buf.Artifact.User)
 	assert.Equal(t, "pass", buf.Artifact.Pass)
 	assert.Equal(t, false, buf.Artifact.Cleanup)
+	assert.Equal(t, int64(1000000000), buf.Container.NanoCPUs)
+	assert.Equal(t, int64(1073741824), buf.Container.Memory)
+	assert.Equal(t, "none", buf.Container.Network)
+	assert.Equal(t, "1000:1000", buf.Container.User)
+	assert.Equal(t, true, buf.Container.ReadOnly)
+	assert.Equal(t, []string{"name1=value1"}, buf.Container.Env)
+	assert.Equal(t, []task.Tmpfs{{Target: "/tmp", Size: 67108864}}, buf.Container.Tmpfs)
+}
+
+func TestBuildStatus(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	status := task.Status{
+		ExitCode:   137,
+		Signal:     "killed",
+		OOMKilled:  true,
+		Reason:     task.ReasonOOMKilled,
+		StartTime:  1,
+		EndTime:    3,
+		Duration:   2,
+		MemoryPeak: 4,
+		CPUTime:    5,
+	}
+
+	buf := s.buildStatus(ctx, &status)
+	assert.NotEqual(t, nil, buf)
+	assert.Equal(t, int64(137), buf.GetExitCode())
+	assert.Equal(t, "killed", buf.GetSignal())
+	assert.Equal(t, true, buf.GetOomKilled())
+	assert.Equal(t, task.ReasonOOMKilled, buf.GetReason())
+	assert.Equal(t, int64(1), buf.GetStartTime())
+	assert.Equal(t, int64(3), buf.GetEndTime())
+	assert.Equal(t, int64(2), buf.GetDuration())
+	assert.Equal(t, int64(4), buf.GetMemoryPeak())
+	assert.Equal(t, int64(5), buf.GetCpuTime())
+}
+
+func TestTaskRegistry(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	id := s.newId()
+	assert.Equal(t, IdLen*2, len(id))
+	assert.NotEqual(t, id, s.newId())
+
+	_t, err := s.newTask(ctx)
+	assert.Equal(t, nil, err)
+
+	queued, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.addTask(id, _t, cancel)
+
+	buf, ok := s.getTask(id)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, _t, buf)
+
+	s.dequeueTask(id)
+	s.cancelQueued(id)
+	assert.Equal(t, nil, queued.Err())
+
+	s.delTask(id)
+
+	_, ok = s.getTask(id)
+	assert.Equal(t, false, ok)
+}
+
+func TestBuildRequests(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	requests := s.buildRequests(ctx, nil)
+	assert.Equal(t, glance.Resource{}, requests)
+
+	requests = s.buildRequests(ctx, &pb.TaskRequests{MilliCPU: 1000, Memory: 1024, Storage: 2048})
+	assert.Equal(t, glance.Resource{MilliCPU: 1000, Memory: 1024, Storage: 2048}, requests)
+}
+
+func TestBuildGrace(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	assert.Equal(t, task.Grace, s.buildGrace(ctx, 0))
+	assert.Equal(t, 3*time.Second, s.buildGrace(ctx, 3))
+
+	s.cfg.Config.Spec.Task.Grace = 5 * time.Second
+	assert.Equal(t, 5*time.Second, s.buildGrace(ctx, 0))
+}
+
+func TestBuildLimit(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	buf := s.buildLimit(ctx, 0, 0, nil)
+	assert.Equal(t, time.Duration(0), buf.Timeout)
+	assert.Equal(t, true, buf.Deadline.IsZero())
+	assert.Equal(t, task.Resources{}, buf.Resources)
+
+	resources := &pb.TaskResources{
+		MilliCPU: 500,
+		Memory:   1024,
+		Pids:     10,
+		IoWeight: 100,
+	}
+
+	buf = s.buildLimit(ctx, 10, 1257894000, resources)
+	assert.Equal(t, 10*time.Second, buf.Timeout)
+	assert.Equal(t, int64(1257894000), buf.Deadline.Unix())
+	assert.Equal(t, int64(500), buf.Resources.MilliCPU)
+	assert.Equal(t, int64(1024), buf.Resources.Memory)
+	assert.Equal(t, int64(10), buf.Resources.Pids)
+	assert.Equal(t, int64(100), buf.Resources.IOWeight)
+}
+
+func TestSendArchive(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	f, err := s.newFile(ctx)
+	assert.Equal(t, nil, err)
+
+	dir := t.TempDir()
+	err = os.WriteFile(filepath.Join(dir, "out.txt"), bytes.Repeat([]byte("a"), 3*ArchiveChunk), 0644)
+	assert.Equal(t, nil, err)
+
+	srv := &sendTaskServer{}
+	err = s.sendArchive(ctx, srv, f, dir, &pb.TaskArtifacts{Patterns: []string{"*.txt"}})
+	assert.Equal(t, nil, err)
+	assert.Less(t, 0, len(srv.replies))
+
+	var buf []byte
+
+	for i, item := range srv.replies {
+		assert.Equal(t, int64(i+1), item.GetArchive().GetPos())
+		assert.Equal(t, i == len(srv.replies)-1, item.GetArchive().GetLast())
+		buf = append(buf, item.GetArchive().GetContent()...)
+	}
+
+	sums := srv.replies[len(srv.replies)-1].GetArchive().GetChecksums()
+	assert.Equal(t, 1, len(sums))
+	assert.Equal(t, "out.txt", sums[0].GetName())
+	assert.Equal(t, int64(3*ArchiveChunk), sums[0].GetSize())
+
+	_, err = f.Unzip(ctx, buf)
+	assert.Equal(t, nil, err)
+
+	srv = &sendTaskServer{}
+	err = s.sendArchive(ctx, srv, f, dir, &pb.TaskArtifacts{Patterns: []string{"*.txt"}, MaxSize: 1})
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, 0, len(srv.replies))
+}
+
+func TestLoadBundle(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	ctx := context.Background()
+
+	f, err := s.newFile(ctx)
+	assert.Equal(t, nil, err)
+
+	var b bytes.Buffer
+
+	w := tar.NewWriter(&b)
+	data := []byte("#!/bin/bash\necho \"Hello World!\"")
+	_ = w.WriteHeader(&tar.Header{Name: "src/main.sh", Typeflag: tar.TypeReg, Mode: 0755, Size: int64(len(data))})
+	_, _ = w.Write(data)
+	_ = w.Close()
+
+	buf, err := initZip(b.Bytes())
+	assert.Equal(t, nil, err)
+
+	dir := t.TempDir()
+	path, err := s.loadBundle(ctx, f, dir, buf, "")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", path)
+
+	dir = t.TempDir()
+	path, err = s.loadBundle(ctx, f, dir, buf, "src/main.sh")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, filepath.Join(dir, "src", "main.sh"), path)
+
+	_, err = s.loadBundle(ctx, f, t.TempDir(), buf, "src/invalid.sh")
+	assert.NotEqual(t, nil, err)
+
+	_, err = s.loadBundle(ctx, f, t.TempDir(), buf, "../main.sh")
+	assert.NotEqual(t, nil, err)
+
+	_, err = s.loadBundle(ctx, f, t.TempDir(), []byte("invalid"), "")
+	assert.NotEqual(t, nil, err)
+}
+
+type resizeTask struct {
+	task.Task
+	sizes [][2]uint16
+}
+
+func (t *resizeTask) Resize(_ context.Context, rows, cols uint16) error {
+	t.sizes = append(t.sizes, [2]uint16{rows, cols})
+	return nil
+}
+
+func TestRecvInput(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	ctx := context.Background()
+
+	stdin := func(content string, _close bool) *pb.TaskRequest {
+		return &pb.TaskRequest{
+			Kind: Kind,
+			Spec: &pb.TaskSpec{
+				Stdin: &pb.TaskStdin{Content: []byte(content), Close: _close},
+			},
+		}
+	}
+
+	resize := func(rows, cols uint32) *pb.TaskRequest {
+		return &pb.TaskRequest{
+			Kind: Kind,
+			Spec: &pb.TaskSpec{
+				Resize: &pb.TaskSize{Rows: rows, Cols: cols},
+			},
+		}
+	}
+
+	srv := &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			stdin("hello ", false),
+			{Kind: "invalid"},
+			stdin("world\n", true),
+			stdin("ignored", false),
+		},
+	}
+
+	buf := &stdinBuffer{}
+	s.recvInput(ctx, srv, &resizeTask{}, buf, false)
+	assert.Equal(t, "hello world\n", buf.String())
+	assert.Equal(t, true, buf.closed)
+	assert.Equal(t, 1, len(srv.requests))
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			stdin("hello", false),
+		},
+	}
+
+	buf = &stdinBuffer{}
+	s.recvInput(ctx, srv, &resizeTask{}, buf, false)
+	assert.Equal(t, "hello", buf.String())
+	assert.Equal(t, true, buf.closed)
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			resize(30, 100),
+			stdin("hello", true),
+			resize(40, 120),
+		},
+	}
+
+	buf = &stdinBuffer{}
+	_t := &resizeTask{}
+	s.recvInput(ctx, srv, _t, buf, true)
+	assert.Equal(t, "hello", buf.String())
+	assert.Equal(t, true, buf.closed)
+	assert.Equal(t, [][2]uint16{{30, 100}, {40, 120}}, _t.sizes)
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			resize(30, 100),
+		},
+	}
+
+	_t = &resizeTask{}
+	s.recvInput(ctx, srv, _t, nil, false)
+	assert.Equal(t, 0, len(_t.sizes))
+	assert.Equal(t, 1, len(srv.requests))
+}
+
+func TestSendTask(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	s.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	defer func(ctx context.Context) {
+		_ = s.Deinit(ctx)
+	}(ctx)
+
+	f := &task.Fake{
+		Stdout:   "out1\nout2\n",
+		Status:   task.Status{ExitCode: 3, Reason: task.ReasonExited},
+		Progress: []task.Progress{{ID: "layer1", Status: "Downloading", Current: 1, Total: 2}},
+	}
+
+	task.Register("fake-send", func(_ context.Context, _ *task.Config) task.Executor {
+		return f
+	})
+
+	request := func(spec *pb.Task) *pb.TaskRequest {
+		return &pb.TaskRequest{
+			Kind: Kind,
+			Spec: &pb.TaskSpec{
+				Task: spec,
+			},
+		}
+	}
+
+	srv := &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			request(&pb.Task{
+				Name:     "task",
+				Params:   []*pb.TaskParam{{Name: "env", Value: "val"}},
+				Commands: []string{"cmd"},
+				Language: &pb.TaskLanguage{Name: "fake-send", Artifact: &pb.TaskArtifact{PullPolicy: "Never"}},
+			}),
+		},
+	}
+
+	err = s.SendTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 6, len(srv.replies))
+	assert.Equal(t, IdLen*2, len(srv.replies[0].GetId()))
+	assert.Equal(t, "layer1", srv.replies[1].GetProgress().GetId())
+	assert.Equal(t, "Downloading", srv.replies[1].GetProgress().GetStatus())
+	assert.Equal(t, int64(1), srv.replies[1].GetProgress().GetCurrent())
+	assert.Equal(t, int64(2), srv.replies[1].GetProgress().GetTotal())
+	assert.Equal(t, "out1\n", srv.replies[2].GetOutput().GetMessage())
+	assert.Equal(t, task.StreamStdout, srv.replies[2].GetOutput().GetStream())
+	assert.Equal(t, "out2\n", srv.replies[3].GetOutput().GetMessage())
+	assert.Equal(t, EOF, srv.replies[4].GetOutput().GetMessage())
+	assert.Equal(t, int64(3), srv.replies[5].GetStatus().GetExitCode())
+	assert.Equal(t, task.ReasonExited, srv.replies[5].GetStatus().GetReason())
+	assert.Equal(t, task.PullNever, f.Lang.Artifact.PullPolicy)
+
+	assert.Equal(t, []string{"cmd"}, f.Spec.Cmd)
+	assert.Contains(t, f.Spec.Env, EnvTaskName+"=task")
+	assert.Equal(t, "env=val", f.Spec.Env[len(f.Spec.Env)-1])
+	assert.Equal(t, []string(nil), f.Spec.Environ)
+	assert.Equal(t, s.cfg.Config.Spec.Workspace.Root, filepath.Dir(f.Spec.Dir))
+	assert.Equal(t, true, f.Cleaned)
+
+	_, err = os.Stat(f.Spec.Dir)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			request(&pb.Task{
+				File:     &pb.TaskFile{Content: []byte("#!/bin/bash")},
+				Commands: []string{"cmd"},
+				Language: &pb.TaskLanguage{Name: "fake-send"},
+			}),
+		},
+	}
+
+	err = s.SendTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(srv.replies))
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			request(&pb.Task{
+				Params:   []*pb.TaskParam{{Name: "env1", Value: "$env2"}, {Name: "env2", Value: "$env1"}},
+				Commands: []string{"cmd"},
+				Language: &pb.TaskLanguage{Name: "fake-send"},
+			}),
+		},
+	}
+
+	err = s.SendTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(srv.replies))
+	assert.Equal(t, "failed to expand env1: cyclic reference: env1 -> env2 -> env1", srv.replies[0].GetError())
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			request(&pb.Task{
+				Commands: []string{"cmd"},
+				Log:      &pb.TaskLog{Ansi: "invalid"},
+				Language: &pb.TaskLanguage{Name: "fake-send"},
+			}),
+		},
+	}
+
+	err = s.SendTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(srv.replies))
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+
+	// Deadline is exceeded before running, and the task ends in timeout
+	task.Register("fake-send-deadline", func(_ context.Context, _ *task.Config) task.Executor {
+		return &task.Fake{}
+	})
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			request(&pb.Task{
+				Commands: []string{"cmd"},
+				Deadline: time.Now().Add(-time.Hour).Unix(),
+				Language: &pb.TaskLanguage{Name: "fake-send-deadline"},
+			}),
+		},
+	}
+
+	err = s.SendTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(srv.replies))
+	assert.Equal(t, IdLen*2, len(srv.replies[0].GetId()))
+	assert.Equal(t, EOF, srv.replies[1].GetOutput().GetMessage())
+	assert.Equal(t, task.ReasonTimeout, srv.replies[2].GetStatus().GetReason())
+
+	srv = &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			{Kind: "invalid"},
+		},
+	}
+
+	err = s.SendTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(srv.replies))
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+}
+
+type sendImageServer struct {
+	grpc.ServerStream
+	requests []*pb.ImageRequest
+	replies  []*pb.ImageReply
+}
+
+func (s *sendImageServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *sendImageServer) Send(reply *pb.ImageReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func (s *sendImageServer) Recv() (*pb.ImageRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return r, nil
+}
+
+type imageCache struct {
+	task.Cache
+	images  []task.Image
+	evicted bool
+}
+
+func (c *imageCache) Evict(_ context.Context) error {
+	c.evicted = true
+	return nil
+}
+
+func (c *imageCache) Inventory(_ context.Context) ([]task.Image, error) {
+	return c.images, nil
+}
+
+func TestSendImage(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	s.cfg.Config.Spec.Cache.MaxSize = 100
+
+	srv := &sendImageServer{}
+
+	err := s.SendImage(srv)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+
+	c := &imageCache{
+		images: []task.Image{
+			{ID: "id1", Tags: []string{"image1:latest"}, Size: 10, LastUsed: 1, Pinned: 1},
+			{ID: "id2", Tags: []string{"image2:latest"}, Size: 20, LastUsed: 2},
+		},
+	}
+
+	s.cache = c
+
+	srv = &sendImageServer{
+		requests: []*pb.ImageRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.ImageSpec{
+					Image: &pb.Image{Evict: true},
+				},
+			},
+		},
+	}
+
+	err = s.SendImage(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, c.evicted)
+	assert.Equal(t, 1, len(srv.replies))
+	assert.Equal(t, int64(30), srv.replies[0].GetSize())
+	assert.Equal(t, int64(100), srv.replies[0].GetMaxSize())
+	assert.Equal(t, 2, len(srv.replies[0].GetImages()))
+	assert.Equal(t, "id1", srv.replies[0].GetImages()[0].GetId())
+	assert.Equal(t, []string{"image1:latest"}, srv.replies[0].GetImages()[0].GetTags())
+	assert.Equal(t, int64(1), srv.replies[0].GetImages()[0].GetPinned())
+
+	srv = &sendImageServer{
+		requests: []*pb.ImageRequest{{Kind: "invalid"}},
+	}
+
+	err = s.SendImage(srv)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+}
+
+type queueTaskServer struct {
+	sendTaskServer
+	sent chan struct{}
+}
+
+func (s *queueTaskServer) Send(reply *pb.TaskReply) error {
+	s.replies = append(s.replies, reply)
+	if reply.GetQueue() != nil || reply.GetProgress() != nil {
+		select {
+		case s.sent <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+type cancelTaskServer struct {
+	grpc.ServerStream
+	requests []*pb.CancelRequest
+	replies  []*pb.CancelReply
+}
+
+func (s *cancelTaskServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *cancelTaskServer) Send(reply *pb.CancelReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func (s *cancelTaskServer) Recv() (*pb.CancelRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return r, nil
+}
+
+func TestSendTaskQueue(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	s.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+	s.cfg.Config.Spec.Queue.MaxTasks = 1
+	s.cfg.Config.Spec.Queue.MaxSize = 1
+
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	defer func(ctx context.Context) {
+		_ = s.Deinit(ctx)
+	}(ctx)
+
+	task.Register("fake-queue", func(_ context.Context, _ *task.Config) task.Executor {
+		return &task.Fake{Stdout: "out1\n"}
+	})
+
+	request := func() []*pb.TaskRequest {
+		return []*pb.TaskRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.TaskSpec{
+					Task: &pb.Task{
+						Commands: []string{"cmd"},
+						Language: &pb.TaskLanguage{Name: "fake-queue"},
+						Priority: 1,
+					},
+				},
+			},
+		}
+	}
+
+	// Slot is taken by the running task
+	err = s.queue.Acquire(ctx, 0, glance.Resource{}, func(int64) {})
+	assert.Equal(t, nil, err)
+
+	// Task is cancelled in queue, and the slot is never taken
+	srv := &queueTaskServer{
+		sendTaskServer: sendTaskServer{requests: request()},
+		sent:           make(chan struct{}, 1),
+	}
+
+	done := make(chan error)
+
+	go func() {
+		done <- s.SendTask(srv)
+	}()
+
+	<-srv.sent
+
+	cancel := &cancelTaskServer{
+		requests: []*pb.CancelRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.CancelSpec{
+					Cancel: &pb.Cancel{Id: srv.replies[0].GetId()},
+				},
+			},
+		},
+	}
+
+	err = s.CancelTask(cancel)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", cancel.replies[0].GetError())
+
+	err = <-done
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(srv.replies))
+	assert.Equal(t, IdLen*2, len(srv.replies[0].GetId()))
+	assert.Equal(t, int64(1), srv.replies[1].GetQueue().GetPosition())
+	assert.Equal(t, task.ReasonCancelled, srv.replies[2].GetStatus().GetReason())
+
+	srv = &queueTaskServer{
+		sendTaskServer: sendTaskServer{requests: request()},
+		sent:           make(chan struct{}, 1),
+	}
+
+	go func() {
+		done <- s.SendTask(srv)
+	}()
+
+	<-srv.sent
+
+	full := &sendTaskServer{requests: request()}
+
+	err = s.SendTask(full)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, len(full.replies))
+	assert.Equal(t, IdLen*2, len(full.replies[0].GetId()))
+
+	exceeded := &sendTaskServer{requests: request()}
+	exceeded.requests[0].Spec.Task.Requests = &pb.TaskRequests{MilliCPU: math.MaxInt64}
+
+	err = s.SendTask(exceeded)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	s.queue.Release(ctx, glance.Resource{})
+
+	err = <-done
+	assert.Equal(t, nil, err)
+	assert.Equal(t, IdLen*2, len(srv.replies[0].GetId()))
+	assert.Equal(t, int64(1), srv.replies[1].GetQueue().GetPosition())
+	assert.Equal(t, "out1\n", srv.replies[2].GetOutput().GetMessage())
+}
+
+func TestSendTaskCancelInit(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	s.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	defer func(ctx context.Context) {
+		_ = s.Deinit(ctx)
+	}(ctx)
+
+	f := &task.Fake{
+		Progress: []task.Progress{{ID: "image", Status: "Pulling"}},
+		Hold:     true,
+	}
+
+	task.Register("fake-cancel-init", func(_ context.Context, _ *task.Config) task.Executor {
+		return f
+	})
+
+	// Task is cancelled while image is pulled
+	srv := &queueTaskServer{
+		sendTaskServer: sendTaskServer{
+			requests: []*pb.TaskRequest{
+				{
+					Kind: Kind,
+					Spec: &pb.TaskSpec{
+						Task: &pb.Task{
+							Commands: []string{"cmd"},
+							Language: &pb.TaskLanguage{Name: "fake-cancel-init"},
+						},
+					},
+				},
+			},
+		},
+		sent: make(chan struct{}, 1),
+	}
+
+	done := make(chan error)
+
+	go func() {
+		done <- s.SendTask(srv)
+	}()
+
+	<-srv.sent
+
+	id := srv.replies[0].GetId()
+
+	cancel := &cancelTaskServer{
+		requests: []*pb.CancelRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.CancelSpec{
+					Cancel: &pb.Cancel{Id: id},
+				},
+			},
+		},
+	}
+
+	err = s.CancelTask(cancel)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", cancel.replies[0].GetError())
+
+	err = <-done
+	assert.Equal(t, nil, err)
+
+	reply := srv.replies[len(srv.replies)-1]
+	assert.Equal(t, "", reply.GetError())
+	assert.Equal(t, task.ReasonCancelled, reply.GetStatus().GetReason())
+	assert.Equal(t, true, f.Cleaned)
+
+	// Status is stored for attaching
+	status, err := s.store.ReadStatus(ctx, id)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, task.ReasonCancelled, status.Reason)
+}
+
+type getTaskLogServer struct {
+	grpc.ServerStream
+	requests []*pb.LogRequest
+	replies  []*pb.LogReply
+}
+
+func (s *getTaskLogServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *getTaskLogServer) Send(reply *pb.LogReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func (s *getTaskLogServer) Recv() (*pb.LogRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return r, nil
+}
+
+func TestGetTaskLog(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	srv := &getTaskLogServer{}
+
+	err := s.GetTaskLog(srv)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+
+	s.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+
+	ctx := context.Background()
+
+	err = s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	defer func(ctx context.Context) {
+		_ = s.Deinit(ctx)
+	}(ctx)
+
+	task.Register("fake-log", func(_ context.Context, _ *task.Config) task.Executor {
+		return &task.Fake{Stdout: "out1\nout2\nout3\n"}
+	})
+
+	send := &sendTaskServer{
+		requests: []*pb.TaskRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.TaskSpec{
+					Task: &pb.Task{
+						Commands: []string{"cmd"},
+						Language: &pb.TaskLanguage{Name: "fake-log"},
+					},
+				},
+			},
+		},
+	}
+
+	err = s.SendTask(send)
+	assert.Equal(t, nil, err)
+
+	request := func(id string, seq int64) []*pb.LogRequest {
+		return []*pb.LogRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.LogSpec{
+					Log: &pb.Log{Id: id, Seq: seq, Follow: true},
+				},
+			},
+		}
+	}
+
+	srv = &getTaskLogServer{
+		requests: request(send.replies[0].GetId(), 2),
+	}
+
+	err = s.GetTaskLog(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(srv.replies))
+	assert.Equal(t, int64(2), srv.replies[0].GetOutput().GetPos())
+	assert.Equal(t, "out2\n", srv.replies[0].GetOutput().GetMessage())
+	assert.Equal(t, task.StreamStdout, srv.replies[0].GetOutput().GetStream())
+	assert.Equal(t, "out3\n", srv.replies[1].GetOutput().GetMessage())
+	assert.Equal(t, EOF, srv.replies[2].GetOutput().GetMessage())
+
+	srv = &getTaskLogServer{
+		requests: request("invalid", 0),
+	}
+
+	err = s.GetTaskLog(srv)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+}
+
+type detachTaskServer struct {
+	sendTaskServer
+}
+
+func (s *detachTaskServer) Context() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+type attachTaskServer struct {
+	grpc.ServerStream
+	requests []*pb.AttachRequest
+	replies  []*pb.TaskReply
+}
+
+func (s *attachTaskServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *attachTaskServer) Send(reply *pb.TaskReply) error {
+	s.replies = append(s.replies, reply)
+	return nil
+}
+
+func (s *attachTaskServer) Recv() (*pb.AttachRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	r := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return r, nil
+}
+
+func TestAttachTask(t *testing.T) {
+	s := server{
+		cfg: DefaultConfig(),
+	}
+
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "server",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	srv := &attachTaskServer{}
+
+	err := s.AttachTask(srv)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", srv.replies[0].GetError())
+
+	s.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+
+	ctx := context.Background()
+
+	err = s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	defer func(ctx context.Context) {
+		_ = s.Deinit(ctx)
+	}(ctx)
+
+	task.Register("fake-attach", func(_ context.Context, _ *task.Config) task.Executor {
+		return &task.Fake{Stdout: "out1\nout2\nout3\n", Stderr: "err1\nerr2\n", Output: "key=val\n"}
+	})
+
+	// Client is disconnected, and the detached task still runs to completion
+	send := &detachTaskServer{
+		sendTaskServer{
+			requests: []*pb.TaskRequest{
+				{
+					Kind: Kind,
+					Spec: &pb.TaskSpec{
+						Task: &pb.Task{
+							Commands: []string{"cmd"},
+							Language: &pb.TaskLanguage{Name: "fake-attach"},
+							Detach:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	err = s.SendTask(send)
+	assert.Equal(t, nil, err)
+
+	request := func(id string, seq int64) []*pb.AttachRequest {
+		return []*pb.AttachRequest{
+			{
+				Kind: Kind,
+				Spec: &pb.AttachSpec{
+					Attach: &pb.Attach{Id: id, Seq: seq},
+				},
+			},
+		}
+	}
+
+	srv = &attachTaskServer{
+		requests: request(send.replies[0].GetId(), 0),
+	}
+
+	err = s.AttachTask(srv)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 7, len(srv.replies))
+	assert.Equal(t, EOF, srv.replies[5].GetOutput().GetMessage())
+	assert.Equal(t, int64(0), srv.replies[6].GetStatus().GetExitCode())
+	assert.NotEqual(t, (*pb.TaskStatus)(nil), srv.replies[6].GetStatus())
+	assert.Equal(t, map[string]string{"key": "val"}, srv.replies[6].GetStatus().GetOutputs())
+
+	for i := range srv.replies[:6] {
+		assert.Equal(t, int64(i+1), srv.replies[i].GetOutput().GetSeq())
+	}
+
+	// Stdout and stderr are interleaved, and resume from sequence number loses or duplicates no line
+	replies := srv.replies
+
+	for received := 0; received < 6; received++ {
+		srv = &attachTaskServer{
+			requests: request(send.replies[0].GetId(), replies[received].GetOutput().GetSeq()+1),
+		}
+		err = s.AttachTask(srv)
+		assert.Equal(t, nil, err)
+		if received < 5 {
+			assert.Equal(t, replies[received+1:6], srv.replies[:len(srv.replies)-1])
+		} else {
+			assert.Equal(t, replies[5:6], srv.replies[:len(srv.replies)-1])
+		}
+	}
+
+	srv = &attachTaskServer{
+		requests: request("invalid", 0),
+	}
+
+	err = s.AttachTask(srv)
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", srv.replies[0].GetError())
 }
@@ -0,0 +1,405 @@
+package store
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/pipego/runner/config"
+	"github.com/pipego/runner/task"
+)
+
+const (
+	Perm      = 0755
+	FilePerm  = 0644
+	Retention = 24 * time.Hour
+	Root      = "pipego-runner-log"
+)
+
+const (
+	extIndex  = ".idx"
+	extLog    = ".log"
+	extStatus = ".sts"
+	indexSize = 16
+	lineSep   = '\n'
+)
+
+// Store persists the lines of task in append-only log, and the offset of log is indexed by sequence number
+// for replay. The log and status are kept for retention after closed.
+type Store interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Open(context.Context, string) error
+	Write(context.Context, string, *task.Line) error
+	WriteStatus(context.Context, string, *task.Status) error
+	Close(context.Context, string) error
+	Read(context.Context, string, int64, bool, func(*task.Line) error) error
+	ReadStatus(context.Context, string) (task.Status, error)
+	Purge(context.Context) error
+}
+
+type Config struct {
+	Config config.Config
+	Logger hclog.Logger
+}
+
+type store struct {
+	cfg  *Config
+	mu   sync.Mutex
+	logs map[string]*entry
+}
+
+// entry is the log opened for writing, and notify is closed as the log is written or closed
+type entry struct {
+	log    *os.File
+	index  *os.File
+	size   int64
+	seq    int64
+	notify chan struct{}
+}
+
+type record struct {
+	Seq     int64  `json:"seq"`
+	Pos     int64  `json:"pos"`
+	Time    int64  `json:"time"`
+	Message string `json:"message"`
+	Stream  string `json:"stream"`
+}
+
+func New(_ context.Context, cfg *Config) Store {
+	return &store{
+		cfg:  cfg,
+		logs: map[string]*entry{},
+	}
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Init creates root, and removes the logs left by the previous runner if they are expired
+func (s *store) Init(ctx context.Context) error {
+	if err := os.MkdirAll(s.root(), Perm); err != nil {
+		return errors.Wrap(err, "failed to make root")
+	}
+
+	return s.Purge(ctx)
+}
+
+func (s *store) Deinit(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, e := range s.logs {
+		s.closeEntry(e)
+		delete(s.logs, id)
+	}
+
+	return nil
+}
+
+// Open creates the log of task id in root
+func (s *store) Open(ctx context.Context, id string) error {
+	if !s.validId(id) {
+		return errors.New("invalid id")
+	}
+
+	_ = s.Purge(ctx)
+
+	flag := os.O_CREATE | os.O_EXCL | os.O_WRONLY | os.O_APPEND
+
+	log, err := os.OpenFile(s.path(id, extLog), flag, FilePerm)
+	if err != nil {
+		return errors.Wrap(err, "failed to open log")
+	}
+
+	index, err := os.OpenFile(s.path(id, extIndex), flag, FilePerm)
+	if err != nil {
+		_ = log.Close()
+		_ = os.Remove(s.path(id, extLog))
+		return errors.Wrap(err, "failed to open index")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logs[id] = &entry{
+		log:    log,
+		index:  index,
+		notify: make(chan struct{}),
+	}
+
+	return nil
+}
+
+// Write appends line into log, and the offset is indexed if the sequence number of line is greater than the indexed
+func (s *store) Write(_ context.Context, id string, line *task.Line) error {
+	buf, err := json.Marshal(record{
+		Seq:     line.Seq,
+		Pos:     line.Pos,
+		Time:    line.Time,
+		Message: line.Message,
+		Stream:  line.Stream,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.logs[id]
+	if !ok {
+		return errors.New("log not opened")
+	}
+
+	if line.Seq > e.seq {
+		b := make([]byte, indexSize)
+		binary.BigEndian.PutUint64(b[:indexSize/2], uint64(line.Seq))
+		binary.BigEndian.PutUint64(b[indexSize/2:], uint64(e.size))
+		if _, err := e.index.Write(b); err != nil {
+			return errors.Wrap(err, "failed to write index")
+		}
+		e.seq = line.Seq
+	}
+
+	n, err := e.log.Write(append(buf, lineSep))
+	e.size += int64(n)
+
+	close(e.notify)
+	e.notify = make(chan struct{})
+
+	if err != nil {
+		return errors.Wrap(err, "failed to write log")
+	}
+
+	return nil
+}
+
+// WriteStatus saves the final status of task id, which must be called before Close
+func (s *store) WriteStatus(_ context.Context, id string, status *task.Status) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.logs[id]; !ok {
+		return errors.New("log not opened")
+	}
+
+	buf, err := json.Marshal(status)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal")
+	}
+
+	if err := os.WriteFile(s.path(id, extStatus), buf, FilePerm); err != nil {
+		return errors.Wrap(err, "failed to write status")
+	}
+
+	return nil
+}
+
+// Close closes the log of task id, which is kept until expired in retention
+func (s *store) Close(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.logs[id]
+	if !ok {
+		return errors.New("log not opened")
+	}
+
+	s.closeEntry(e)
+	delete(s.logs, id)
+
+	return nil
+}
+
+// Read replays the lines of task id from sequence number, and EOF is always replayed. The lines written later
+// are followed until the log is closed if follow is enabled.
+func (s *store) Read(ctx context.Context, id string, seq int64, follow bool, fn func(*task.Line) error) error {
+	if !s.validId(id) {
+		return errors.New("invalid id")
+	}
+
+	offset, err := s.seek(id, seq)
+	if err != nil {
+		return errors.Wrap(err, "failed to seek log")
+	}
+
+	log, err := os.Open(s.path(id, extLog))
+	if err != nil {
+		return errors.Wrap(err, "failed to open log")
+	}
+
+	defer func(log *os.File) {
+		_ = log.Close()
+	}(log)
+
+	if _, err := log.Seek(offset, io.SeekStart); err != nil {
+		return errors.Wrap(err, "failed to seek log")
+	}
+
+	reader := bufio.NewReader(log)
+
+	var buf []byte
+
+	for {
+		b, err := reader.ReadBytes(lineSep)
+		offset += int64(len(b))
+		buf = append(buf, b...)
+
+		if err == nil {
+			var r record
+			if err := json.Unmarshal(buf, &r); err != nil {
+				return errors.Wrap(err, "failed to unmarshal")
+			}
+			buf = buf[:0]
+			if r.Stream == "" || r.Seq >= seq {
+				if err := fn(&task.Line{Seq: r.Seq, Pos: r.Pos, Time: r.Time, Message: r.Message, Stream: r.Stream}); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if !errors.Is(err, io.EOF) {
+			return errors.Wrap(err, "failed to read log")
+		}
+
+		if !follow {
+			return nil
+		}
+
+		s.mu.Lock()
+		e, ok := s.logs[id]
+		if !ok {
+			s.mu.Unlock()
+			// Log is closed, and the rest of log is read once more
+			follow = false
+			continue
+		}
+		size, notify := e.size, e.notify
+		s.mu.Unlock()
+
+		if size > offset {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-notify:
+		}
+	}
+}
+
+// ReadStatus returns the final status of task id, which is not found if the task is not done
+func (s *store) ReadStatus(_ context.Context, id string) (task.Status, error) {
+	var status task.Status
+
+	if !s.validId(id) {
+		return status, errors.New("invalid id")
+	}
+
+	buf, err := os.ReadFile(s.path(id, extStatus))
+	if err != nil {
+		return status, errors.Wrap(err, "failed to read status")
+	}
+
+	if err := json.Unmarshal(buf, &status); err != nil {
+		return status, errors.Wrap(err, "failed to unmarshal")
+	}
+
+	return status, nil
+}
+
+// Purge removes the logs closed and expired in retention
+func (s *store) Purge(_ context.Context) error {
+	retention := s.cfg.Config.Spec.Log.Retention
+	if retention <= 0 {
+		retention = Retention
+	}
+
+	entries, err := os.ReadDir(s.root())
+	if err != nil {
+		return errors.Wrap(err, "failed to read root")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range entries {
+		ext := filepath.Ext(item.Name())
+		if item.IsDir() || (ext != extLog && ext != extIndex && ext != extStatus) {
+			continue
+		}
+		if _, ok := s.logs[strings.TrimSuffix(item.Name(), ext)]; ok {
+			continue
+		}
+		info, err := item.Info()
+		if err != nil {
+			continue
+		}
+		if time.Since(info.ModTime()) >= retention {
+			_ = os.Remove(filepath.Join(s.root(), item.Name()))
+		}
+	}
+
+	return nil
+}
+
+// seek returns the offset of the first line in sequence number in index, or the last indexed if not found,
+// and the lines before the offset are all in less sequence number
+func (s *store) seek(id string, seq int64) (int64, error) {
+	var offset int64
+
+	if seq <= 1 {
+		return 0, nil
+	}
+
+	buf, err := os.ReadFile(s.path(id, extIndex))
+	if err != nil {
+		return 0, err
+	}
+
+	for i := 0; i+indexSize <= len(buf); i += indexSize {
+		p := int64(binary.BigEndian.Uint64(buf[i : i+indexSize/2]))
+		offset = int64(binary.BigEndian.Uint64(buf[i+indexSize/2 : i+indexSize]))
+		if p >= seq {
+			break
+		}
+	}
+
+	return offset, nil
+}
+
+func (s *store) closeEntry(e *entry) {
+	_ = e.log.Close()
+	_ = e.index.Close()
+	close(e.notify)
+}
+
+func (s *store) validId(id string) bool {
+	return id != "" && !strings.ContainsAny(id, `/\`) && id != "." && id != ".."
+}
+
+func (s *store) path(id, ext string) string {
+	return filepath.Join(s.root(), id+ext)
+}
+
+func (s *store) root() string {
+	if s.cfg.Config.Spec.Log.Root != "" {
+		return s.cfg.Config.Spec.Log.Root
+	}
+
+	return filepath.Join(os.TempDir(), Root)
+}
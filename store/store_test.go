@@ -0,0 +1,275 @@
+package store
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipego/runner/task"
+)
+
+func initStore(t *testing.T, retention time.Duration) *store {
+	s := store{
+		cfg:  DefaultConfig(),
+		logs: map[string]*entry{},
+	}
+
+	s.cfg.Config.Spec.Log.Root = t.TempDir()
+	s.cfg.Config.Spec.Log.Retention = retention
+	s.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "store",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	return &s
+}
+
+func readStore(s *store, id string, seq int64) (lines []*task.Line, err error) {
+	err = s.Read(context.Background(), id, seq, false, func(line *task.Line) error {
+		lines = append(lines, line)
+		return nil
+	})
+
+	return lines, err
+}
+
+func TestInit(t *testing.T) {
+	s := initStore(t, time.Hour)
+	ctx := context.Background()
+
+	root := s.cfg.Config.Spec.Log.Root
+	expired := filepath.Join(root, "expired"+extLog)
+	retained := filepath.Join(root, "retained"+extLog)
+	other := filepath.Join(root, "other")
+
+	_ = os.WriteFile(expired, nil, FilePerm)
+	_ = os.WriteFile(retained, nil, FilePerm)
+	_ = os.WriteFile(other, nil, FilePerm)
+
+	_ = os.Chtimes(expired, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+	_ = os.Chtimes(other, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(expired)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	_, err = os.Stat(retained)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(other)
+	assert.Equal(t, nil, err)
+
+	err = s.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestReadWrite(t *testing.T) {
+	s := initStore(t, time.Hour)
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	err = s.Open(ctx, "../invalid")
+	assert.NotEqual(t, nil, err)
+
+	err = s.Write(ctx, "id", &task.Line{Seq: 1, Pos: 1})
+	assert.NotEqual(t, nil, err)
+
+	err = s.Open(ctx, "id")
+	assert.Equal(t, nil, err)
+
+	err = s.Open(ctx, "id")
+	assert.NotEqual(t, nil, err)
+
+	lines := []*task.Line{
+		{Seq: 1, Pos: 1, Time: 1, Message: "out1\n", Stream: task.StreamStdout},
+		{Seq: 2, Pos: 1, Time: 2, Message: "err1\n", Stream: task.StreamStderr},
+		{Seq: 3, Pos: 2, Time: 3, Message: "out2<BOL>", Stream: task.StreamStdout},
+		{Seq: 4, Pos: 2, Time: 4, Message: "\n", Stream: task.StreamStdout},
+		{Seq: 5, Pos: 3, Time: 5, Message: "out3\n", Stream: task.StreamStdout},
+		{Seq: 6, Pos: 2, Time: 6, Message: "err2\n", Stream: task.StreamStderr},
+		{Seq: 7, Pos: 5, Time: 7, Message: "EOF"},
+	}
+
+	for _, item := range lines {
+		err = s.Write(ctx, "id", item)
+		assert.Equal(t, nil, err)
+	}
+
+	buf, err := readStore(s, "id", 0)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, lines, buf)
+
+	buf, err = readStore(s, "id", 2)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, lines[1:], buf)
+
+	buf, err = readStore(s, "id", 4)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, lines[3:], buf)
+
+	buf, err = readStore(s, "id", 10)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []*task.Line{lines[6]}, buf)
+
+	_, err = s.ReadStatus(ctx, "id")
+	assert.NotEqual(t, nil, err)
+
+	err = s.WriteStatus(ctx, "id", &task.Status{ExitCode: 3, Reason: task.ReasonExited})
+	assert.Equal(t, nil, err)
+
+	err = s.Close(ctx, "id")
+	assert.Equal(t, nil, err)
+
+	err = s.Close(ctx, "id")
+	assert.NotEqual(t, nil, err)
+
+	err = s.WriteStatus(ctx, "id", &task.Status{})
+	assert.NotEqual(t, nil, err)
+
+	status, err := s.ReadStatus(ctx, "id")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, task.Status{ExitCode: 3, Reason: task.ReasonExited}, status)
+
+	_, err = s.ReadStatus(ctx, "../id")
+	assert.NotEqual(t, nil, err)
+
+	buf, err = readStore(s, "id", 6)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, lines[5:], buf)
+
+	_, err = readStore(s, "invalid", 0)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestResume(t *testing.T) {
+	var lines []*task.Line
+
+	s := initStore(t, time.Hour)
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	err = s.Open(ctx, "id")
+	assert.Equal(t, nil, err)
+
+	// Stdout runs ahead of stderr, and the lines are interleaved in sequence number
+	seq, out, errs := int64(0), int64(0), int64(0)
+
+	for i := 0; i < 100; i++ {
+		seq, out = seq+1, out+1
+		lines = append(lines, &task.Line{Seq: seq, Pos: out, Message: "out\n", Stream: task.StreamStdout})
+		if i%10 == 0 {
+			seq, errs = seq+1, errs+1
+			lines = append(lines, &task.Line{Seq: seq, Pos: errs, Message: "err\n", Stream: task.StreamStderr})
+		}
+	}
+
+	lines = append(lines, &task.Line{Seq: seq + 1, Pos: out + 1, Message: "EOF"})
+
+	for _, item := range lines {
+		err = s.Write(ctx, "id", item)
+		assert.Equal(t, nil, err)
+	}
+
+	for _, received := range []int{0, 1, 2, 50, len(lines) - 1} {
+		buf, err := readStore(s, "id", int64(received)+1)
+		assert.Equal(t, nil, err)
+		assert.Equal(t, lines[received:], buf)
+	}
+
+	err = s.Close(ctx, "id")
+	assert.Equal(t, nil, err)
+}
+
+func TestFollow(t *testing.T) {
+	s := initStore(t, time.Hour)
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	err = s.Open(ctx, "id")
+	assert.Equal(t, nil, err)
+
+	err = s.Write(ctx, "id", &task.Line{Seq: 1, Pos: 1, Message: "out1\n", Stream: task.StreamStdout})
+	assert.Equal(t, nil, err)
+
+	read := make(chan *task.Line)
+	done := make(chan error)
+
+	go func() {
+		done <- s.Read(ctx, "id", 1, true, func(line *task.Line) error {
+			read <- line
+			return nil
+		})
+	}()
+
+	assert.Equal(t, "out1\n", (<-read).Message)
+
+	err = s.Write(ctx, "id", &task.Line{Seq: 2, Pos: 2, Message: "out2\n", Stream: task.StreamStdout})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "out2\n", (<-read).Message)
+
+	go func() {
+		_ = s.Write(ctx, "id", &task.Line{Seq: 3, Pos: 3, Message: "EOF"})
+		_ = s.Close(ctx, "id")
+	}()
+
+	assert.Equal(t, "EOF", (<-read).Message)
+	assert.Equal(t, nil, <-done)
+
+	_ctx, cancel := context.WithCancel(ctx)
+
+	err = s.Open(ctx, "cancel")
+	assert.Equal(t, nil, err)
+
+	go func() {
+		done <- s.Read(_ctx, "cancel", 0, true, func(_ *task.Line) error {
+			return nil
+		})
+	}()
+
+	cancel()
+	assert.NotEqual(t, nil, <-done)
+}
+
+func TestPurge(t *testing.T) {
+	s := initStore(t, time.Millisecond)
+	ctx := context.Background()
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	err = s.Open(ctx, "opened")
+	assert.Equal(t, nil, err)
+
+	err = s.Open(ctx, "closed")
+	assert.Equal(t, nil, err)
+
+	err = s.Close(ctx, "closed")
+	assert.Equal(t, nil, err)
+
+	time.Sleep(10 * time.Millisecond)
+
+	err = s.Purge(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(s.path("opened", extLog))
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(s.path("closed", extLog))
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	_, err = os.Stat(s.path("closed", extIndex))
+	assert.Equal(t, true, os.IsNotExist(err))
+}
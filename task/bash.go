@@ -0,0 +1,272 @@
+package task
+
+import (
+	"context"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type bash struct {
+	cfg    *Config
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	cg     *cgroup
+	pty    *os.File
+	stdout io.Reader
+	stderr io.Reader
+	start  time.Time
+	exited chan struct{}
+}
+
+func newBash(_ context.Context, cfg *Config) Executor {
+	return &bash{
+		cfg:    cfg,
+		exited: make(chan struct{}),
+	}
+}
+
+func (b *bash) Prepare(_ context.Context, _ Language, _ func(Progress)) error {
+	return nil
+}
+
+// Start runs commands or file in bash, which is placed in cgroup for the resources in spec
+func (b *bash) Start(ctx context.Context, spec Spec) error {
+	var arg []string
+
+	name, err := exec.LookPath(langBash)
+	if err != nil {
+		return errors.New("name not found")
+	}
+
+	if len(spec.Cmd) != 0 {
+		arg = []string{"-c", strings.Join(spec.Cmd, " ")}
+	} else {
+		if spec.File != "" {
+			arg = []string{"-c", spec.File}
+		} else {
+			return errors.New("invalid file")
+		}
+	}
+
+	c := exec.CommandContext(ctx, name, arg...)
+	environ := spec.Environ
+	if environ == nil {
+		environ = c.Environ()
+	}
+	c.Env = append(append([]string{}, environ...), spec.Env...)
+	if spec.Dir != "" {
+		c.Env = append(c.Env, EnvWorkspace+"="+spec.Dir)
+	}
+	if spec.Output != "" {
+		c.Env = append(c.Env, EnvOutput+"="+spec.Output)
+	}
+	c.Dir = spec.Dir
+	b.setProcAttr(c)
+
+	cg, err := b.initCgroup(spec.Resources)
+	if err != nil {
+		return errors.Wrap(err, "failed to init cgroup")
+	}
+
+	var ptmx *os.File
+
+	if spec.Tty {
+		var tty *os.File
+		ptmx, tty, err = b.openTerminal(c, spec.Rows, spec.Cols)
+		if err != nil {
+			b.deinitCgroup(cg)
+			return errors.Wrap(err, "failed to open terminal")
+		}
+		defer func(tty *os.File) {
+			_ = tty.Close()
+		}(tty)
+		b.stdout = ptmx
+	} else {
+		if spec.Stdin != nil {
+			c.Stdin = spec.Stdin
+		}
+		b.stdout, _ = c.StdoutPipe()
+		b.stderr, _ = c.StderrPipe()
+	}
+
+	if cg != nil {
+		cg.attach(c)
+	}
+
+	b.start = time.Now()
+
+	if err = c.Start(); err != nil {
+		b.deinitCgroup(cg)
+		if ptmx != nil {
+			_ = ptmx.Close()
+		}
+		return errors.Wrap(err, "failed to start")
+	}
+
+	if cg != nil {
+		if err = cg.add(c.Process.Pid); err != nil {
+			_ = b.signalProc(c, syscall.SIGKILL)
+			_ = c.Wait()
+			b.deinitCgroup(cg)
+			if ptmx != nil {
+				_ = ptmx.Close()
+			}
+			return errors.Wrap(err, "failed to add cgroup")
+		}
+	}
+
+	b.mu.Lock()
+	b.cmd, b.cg, b.pty = c, cg, ptmx
+	b.mu.Unlock()
+
+	// Stdin is inherited by process, otherwise it is copied into terminal until closed
+	if ptmx != nil && spec.Stdin != nil {
+		go b.copyTerminal(ptmx, spec.Stdin)
+	}
+
+	return nil
+}
+
+func (b *bash) Stream(_ context.Context) (stdout, stderr io.Reader) {
+	return b.stdout, b.stderr
+}
+
+// Wait waits for bash to exit, which must be called after the output is drained
+func (b *bash) Wait(_ context.Context) Status {
+	b.mu.Lock()
+	c, cg := b.cmd, b.cg
+	b.mu.Unlock()
+
+	if c == nil {
+		return Status{}
+	}
+
+	_ = c.Wait()
+	close(b.exited)
+
+	status := b.processStatus(c.ProcessState, b.start, time.Now())
+
+	if cg != nil {
+		cg.stat(&status)
+	}
+
+	b.deinitCgroup(cg)
+
+	b.mu.Lock()
+	if b.pty != nil {
+		_ = b.pty.Close()
+		b.pty = nil
+	}
+	b.mu.Unlock()
+
+	return status
+}
+
+// Kill sends SIGTERM to the process group of bash, waits for grace and then sends SIGKILL
+func (b *bash) Kill(_ context.Context, grace time.Duration) error {
+	b.mu.Lock()
+	c := b.cmd
+	b.mu.Unlock()
+
+	if c == nil {
+		return nil
+	}
+
+	if err := b.signalProc(c, syscall.SIGTERM); err != nil {
+		return errors.Wrap(err, "failed to terminate process")
+	}
+
+	select {
+	case <-b.exited:
+		return nil
+	case <-time.After(grace):
+	}
+
+	if err := b.signalProc(c, syscall.SIGKILL); err != nil {
+		return errors.Wrap(err, "failed to kill process")
+	}
+
+	return nil
+}
+
+func (b *bash) Resize(_ context.Context, rows, cols uint16) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.pty == nil {
+		return nil
+	}
+
+	if err := b.resizeTerminal(b.pty, rows, cols); err != nil {
+		return errors.Wrap(err, "failed to resize terminal")
+	}
+
+	return nil
+}
+
+func (b *bash) Cleanup(_ context.Context) error {
+	return nil
+}
+
+// copyTerminal writes stdin into terminal, and EOT is written as stdin is closed
+func (b *bash) copyTerminal(ptmx, stdin *os.File) {
+	if _, err := io.Copy(ptmx, stdin); err == nil {
+		_, _ = ptmx.Write([]byte{terminalEOT})
+	}
+}
+
+func (b *bash) processStatus(state *os.ProcessState, start, end time.Time) Status {
+	status := Status{
+		Reason:    ReasonExited,
+		StartTime: start.UnixNano(),
+		EndTime:   end.UnixNano(),
+		Duration:  end.Sub(start).Nanoseconds(),
+	}
+
+	if state == nil {
+		return status
+	}
+
+	status.ExitCode = int64(state.ExitCode())
+
+	if ws, ok := state.Sys().(syscall.WaitStatus); ok && ws.Signaled() {
+		status.ExitCode = int64(signalBase + int(ws.Signal()))
+		status.Signal = ws.Signal().String()
+		status.Reason = ReasonSignaled
+	}
+
+	return status
+}
+
+// initCgroup creates cgroup of bash for the resources, or returns nil without any resources
+func (b *bash) initCgroup(res Resources) (*cgroup, error) {
+	if res == (Resources{}) {
+		return nil, nil
+	}
+
+	root := b.cfg.Config.Spec.Task.Cgroup.Root
+	if root == "" {
+		root = cgroupRoot
+	}
+
+	parent := b.cfg.Config.Spec.Task.Cgroup.Parent
+	if parent == "" {
+		parent = cgroupParent
+	}
+
+	return newCgroup(root, parent, "task-"+strconv.FormatInt(time.Now().UnixNano(), 10), res)
+}
+
+func (b *bash) deinitCgroup(cg *cgroup) {
+	if cg != nil {
+		_ = cg.remove()
+	}
+}
@@ -0,0 +1,412 @@
+//go:build linux || darwin
+
+package task
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunStatus(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"exit 3"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, int64(3), status.ExitCode)
+	assert.Equal(t, "", status.Signal)
+	assert.Equal(t, ReasonExited, status.Reason)
+	assert.Less(t, int64(0), status.Duration)
+
+	_t = initTask()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"kill -9 $$"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	status = _t.Wait(ctx)
+	assert.Equal(t, int64(137), status.ExitCode)
+	assert.Equal(t, "killed", status.Signal)
+	assert.Equal(t, ReasonSignaled, status.Reason)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunCancel(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	go func() {
+		time.Sleep(500 * time.Millisecond)
+		_ = _t.Cancel(ctx, time.Second)
+	}()
+
+	cmd = []string{"trap '' TERM; sleep 10"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, "killed", status.Signal)
+	assert.Equal(t, ReasonCancelled, status.Reason)
+	assert.Less(t, status.Duration, int64(5*time.Second))
+
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.NotEqual(t, nil, err)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunTimeout(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{Timeout: 500 * time.Millisecond})
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"sleep 10"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, ReasonTimeout, status.Reason)
+	assert.Less(t, status.Duration, int64(5*time.Second))
+
+	_t = initTask()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{Deadline: time.Now().Add(-time.Second)})
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	status = _t.Wait(ctx)
+	assert.Equal(t, ReasonTimeout, status.Reason)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunStream(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"echo out1; echo err1 >&2; echo out2"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	log := _t.Tail(ctx)
+	lines := map[string][]*Line{}
+
+L:
+	for {
+		select {
+		case line := <-log.Line.Out:
+			if line.Message == tagEOF {
+				assert.Equal(t, int64(4), line.Pos)
+				break L
+			}
+			lines[line.Stream] = append(lines[line.Stream], line)
+		}
+	}
+
+	assert.Equal(t, 2, len(lines[StreamStdout]))
+	assert.Equal(t, int64(1), lines[StreamStdout][0].Pos)
+	assert.Equal(t, "out1\n", lines[StreamStdout][0].Message)
+	assert.Equal(t, int64(2), lines[StreamStdout][1].Pos)
+	assert.Equal(t, "out2\n", lines[StreamStdout][1].Message)
+	assert.Equal(t, 1, len(lines[StreamStderr]))
+	assert.Equal(t, int64(1), lines[StreamStderr][0].Pos)
+	assert.Equal(t, "err1\n", lines[StreamStderr][0].Message)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunStdin(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	stdin, err := _t.Stdin(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = _t.Stdin(ctx)
+	assert.NotEqual(t, nil, err)
+
+	cmd = []string{"read name; echo hello $name; cat"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	_, err = stdin.Write([]byte("pipego\n"))
+	assert.Equal(t, nil, err)
+	_, err = stdin.Write([]byte("bye\n"))
+	assert.Equal(t, nil, err)
+	_ = stdin.Close()
+
+	log := _t.Tail(ctx)
+	var lines []string
+
+L:
+	for {
+		select {
+		case line := <-log.Line.Out:
+			if line.Message == tagEOF {
+				break L
+			}
+			lines = append(lines, line.Message)
+		}
+	}
+
+	assert.Equal(t, []string{"hello pipego\n", "bye\n"}, lines)
+	assert.Equal(t, int64(0), _t.Wait(ctx).ExitCode)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunPartial(t *testing.T) {
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"printf 'Continue? [y/N] '; echo; printf tail"}, "")
+	assert.Equal(t, nil, err)
+
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, "Continue? [y/N] \n", lines[0].Message)
+	assert.Equal(t, "tail", lines[1].Message)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunPrompt(t *testing.T) {
+	for _, tty := range []bool{false, true} {
+		_t := initTask()
+		ctx := context.Background()
+
+		err := _t.Init(ctx, lineWidth, testBash, Limit{})
+		assert.Equal(t, nil, err)
+
+		if tty {
+			err = _t.Terminal(ctx, 0, 0)
+			assert.Equal(t, nil, err)
+		}
+
+		stdin, err := _t.Stdin(ctx)
+		assert.Equal(t, nil, err)
+
+		err = _t.Run(ctx, "", "", nil, []string{"printf 'name? '; read name; printf \"hello $name\""}, "")
+		assert.Equal(t, nil, err)
+
+		// Prompt is sent before the answer is written into stdin
+		log := _t.Tail(ctx)
+
+		line := <-log.Line.Out
+		assert.Equal(t, "name? ", line.Message)
+
+		_, err = stdin.Write([]byte("pipego\n"))
+		assert.Equal(t, nil, err)
+		_ = stdin.Close()
+
+		var buf strings.Builder
+		for _, item := range drainLog(log) {
+			buf.WriteString(item.Message)
+		}
+		assert.Contains(t, buf.String(), "hello pipego")
+
+		err = _t.Deinit(ctx)
+		assert.Equal(t, nil, err)
+	}
+}
+
+func TestRunTerminal(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	err = _t.Resize(ctx, 30, 100)
+	assert.NotEqual(t, nil, err)
+
+	err = _t.Terminal(ctx, 0, 0)
+	assert.Equal(t, nil, err)
+
+	err = _t.Resize(ctx, 30, 100)
+	assert.Equal(t, nil, err)
+
+	stdin, err := _t.Stdin(ctx)
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"test -t 1 && echo tty; stty size; read name; echo hello $name >&2"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	_, err = stdin.Write([]byte("pipego\n"))
+	assert.Equal(t, nil, err)
+	_ = stdin.Close()
+
+	log := _t.Tail(ctx)
+	var lines []string
+
+L:
+	for {
+		select {
+		case line := <-log.Line.Out:
+			if line.Message == tagEOF {
+				break L
+			}
+			assert.Equal(t, StreamStdout, line.Stream)
+			lines = append(lines, line.Message)
+		}
+	}
+
+	assert.Contains(t, lines, "tty\r\n")
+	assert.Contains(t, lines, "30 100\r\n")
+	assert.Contains(t, lines, "hello pipego\r\n")
+	assert.Equal(t, int64(0), _t.Wait(ctx).ExitCode)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunResources(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	_t.cfg.Config.Spec.Task.Cgroup.Root = t.TempDir()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{Resources: Resources{Pids: 100}})
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"echo $ENV1"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, int64(0), status.ExitCode)
+	assert.Equal(t, ReasonExited, status.Reason)
+
+	entries, _ := os.ReadDir(filepath.Join(_t.cfg.Config.Spec.Task.Cgroup.Root, cgroupParent))
+	for _, item := range entries {
+		assert.Equal(t, false, item.IsDir())
+	}
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunWorkspace(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	dir := t.TempDir()
+
+	cmd = []string{"pwd"}
+	err = _t.Run(ctx, "", dir, env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	log := _t.Tail(ctx)
+
+	line := <-log.Line.Out
+	assert.Equal(t, dir+"\n", line.Message)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunEnviron(t *testing.T) {
+	_t := initTask()
+	ctx := context.Background()
+
+	t.Setenv("PIPEGO_TEST_NAME", "runner")
+
+	err := _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	_t.Environ(ctx, []string{"PATH=/usr/bin:/bin"})
+
+	dir := t.TempDir()
+	cmd := []string{`echo "$PIPEGO_TEST_NAME-$ENV1-$PIPEGO_WORKSPACE"`}
+
+	err = _t.Run(ctx, "", dir, []string{"ENV1=task1"}, cmd, "")
+	assert.Equal(t, nil, err)
+
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 1, len(lines))
+	assert.Equal(t, "-task1-"+dir+"\n", lines[0].Message)
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, int64(0), status.ExitCode)
+}
@@ -0,0 +1,227 @@
+package task
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/client"
+	"github.com/pkg/errors"
+)
+
+// Cache manages the images of docker, which are pinned by running tasks and evicted
+// in least recently used order if their size exceeds the budget
+type Cache interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Pin(context.Context, string)
+	Unpin(context.Context, string, bool) error
+	Evict(context.Context) error
+	Inventory(context.Context) ([]Image, error)
+}
+
+type Image struct {
+	ID       string
+	Tags     []string
+	Size     int64
+	LastUsed int64
+	Pinned   int64
+}
+
+type cache struct {
+	cfg    *Config
+	mu     sync.Mutex
+	client dockerClient
+	used   map[string]time.Time
+	pinned map[string]int64
+}
+
+func NewCache(_ context.Context, cfg *Config) Cache {
+	return &cache{
+		cfg:    cfg,
+		used:   map[string]time.Time{},
+		pinned: map[string]int64{},
+	}
+}
+
+func (c *cache) Init(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return nil
+	}
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return errors.Wrap(err, "failed to new client")
+	}
+
+	c.client = cli
+
+	return nil
+}
+
+func (c *cache) Deinit(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		_ = c.client.Close()
+		c.client = nil
+	}
+
+	return nil
+}
+
+// Pin marks image in use by task, which is never evicted until unpinned
+func (c *cache) Pin(_ context.Context, name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name = imageRef(name)
+
+	c.pinned[name]++
+	c.used[name] = time.Now()
+}
+
+// Unpin releases image of task, and removes it if cleanup is enabled and no more task is using it
+func (c *cache) Unpin(ctx context.Context, name string, cleanup bool) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name = imageRef(name)
+
+	if c.pinned[name] > 0 {
+		c.pinned[name]--
+	}
+
+	c.used[name] = time.Now()
+
+	if c.pinned[name] > 0 {
+		return nil
+	}
+
+	delete(c.pinned, name)
+
+	if !cleanup || c.client == nil {
+		return nil
+	}
+
+	delete(c.used, name)
+
+	options := image.RemoveOptions{
+		PruneChildren: true,
+	}
+
+	if _, err := c.client.ImageRemove(ctx, name, options); err != nil {
+		return errors.Wrap(err, "failed to remove image")
+	}
+
+	return nil
+}
+
+// Evict removes the images not pinned in least recently used order until the total size is in budget,
+// and the images not used since runner started are ordered by creation time
+func (c *cache) Evict(ctx context.Context) error {
+	budget := c.cfg.Config.Spec.Cache.MaxSize
+	if budget <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	images, err := c.inventory(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list images")
+	}
+
+	var total int64
+
+	for _, item := range images {
+		total += item.Size
+	}
+
+	sort.SliceStable(images, func(i, j int) bool {
+		return images[i].LastUsed < images[j].LastUsed
+	})
+
+	options := image.RemoveOptions{
+		Force:         true,
+		PruneChildren: true,
+	}
+
+	for _, item := range images {
+		if total <= budget {
+			break
+		}
+		if item.Pinned > 0 {
+			continue
+		}
+		if _, err := c.client.ImageRemove(ctx, item.ID, options); err != nil {
+			c.cfg.Logger.Warn("Evict", item.ID, err.Error())
+			continue
+		}
+		for _, tag := range item.Tags {
+			delete(c.used, tag)
+		}
+		total -= item.Size
+	}
+
+	return nil
+}
+
+// Inventory returns the images in docker with last use and pins
+func (c *cache) Inventory(ctx context.Context) ([]Image, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.inventory(ctx)
+}
+
+func (c *cache) inventory(ctx context.Context) ([]Image, error) {
+	if c.client == nil {
+		return nil, errors.New("cache not initialized")
+	}
+
+	summary, err := c.client.ImageList(ctx, image.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list")
+	}
+
+	images := make([]Image, 0, len(summary))
+
+	for _, item := range summary {
+		buf := Image{
+			ID:       item.ID,
+			Tags:     item.RepoTags,
+			Size:     item.Size,
+			LastUsed: time.Unix(item.Created, 0).UnixNano(),
+		}
+		for _, tag := range item.RepoTags {
+			tag = imageRef(tag)
+			if used, ok := c.used[tag]; ok && used.UnixNano() > buf.LastUsed {
+				buf.LastUsed = used.UnixNano()
+			}
+			buf.Pinned += c.pinned[tag]
+		}
+		images = append(images, buf)
+	}
+
+	return images, nil
+}
+
+// imageRef returns the familiar reference of image in tag, e.g. craftslab/groovy:latest for
+// docker.io/craftslab/groovy, which is the same as the repository tag in docker
+func imageRef(name string) string {
+	named, err := reference.ParseNormalizedNamed(name)
+	if err != nil {
+		return name
+	}
+
+	return reference.FamiliarString(reference.TagNameOnly(named))
+}
@@ -0,0 +1,93 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/image"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func initCache(c *fakeClient, size int64) *cache {
+	cfg := DefaultConfig()
+	cfg.Config.Spec.Cache.MaxSize = size
+	cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "cache",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	_c := NewCache(context.Background(), cfg).(*cache)
+	_c.client = c
+
+	return _c
+}
+
+func TestImageRef(t *testing.T) {
+	assert.Equal(t, "craftslab/groovy:latest", imageRef("craftslab/groovy"))
+	assert.Equal(t, "craftslab/groovy:latest", imageRef("docker.io/craftslab/groovy:latest"))
+	assert.Equal(t, "ghcr.io/pipego/runner:v1", imageRef("ghcr.io/pipego/runner:v1"))
+	assert.Equal(t, "INVALID", imageRef("INVALID"))
+}
+
+func TestCacheEvict(t *testing.T) {
+	c := newFakeClient()
+	c.list = []image.Summary{
+		{ID: "id1", RepoTags: []string{"image1:latest"}, Size: 10, Created: 1},
+		{ID: "id2", RepoTags: []string{"image2:latest"}, Size: 10, Created: 2},
+		{ID: "id3", RepoTags: []string{"image3:latest"}, Size: 10, Created: 3},
+		{ID: "id4", RepoTags: []string{"image4:latest", "image4:v1"}, Size: 10, Created: 4},
+	}
+
+	_c := initCache(c, 20)
+	ctx := context.Background()
+
+	_c.Pin(ctx, "image1")
+	_c.Pin(ctx, "docker.io/library/image3:latest")
+	err := _c.Unpin(ctx, "image3", false)
+	assert.Equal(t, nil, err)
+
+	images, err := _c.Inventory(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(images))
+	assert.Equal(t, int64(1), images[0].Pinned)
+	assert.Equal(t, time.Unix(2, 0).UnixNano(), images[1].LastUsed)
+	assert.Less(t, time.Unix(4, 0).UnixNano(), images[2].LastUsed)
+
+	// image1 is pinned and image3 is used recently
+	err = _c.Evict(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"id2", "id4"}, c.images)
+
+	c.images = nil
+	_c = initCache(c, 0)
+
+	err = _c.Evict(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(c.images))
+}
+
+func TestCacheUnpin(t *testing.T) {
+	c := newFakeClient()
+	_c := initCache(c, 0)
+	ctx := context.Background()
+
+	_c.Pin(ctx, "image1")
+	_c.Pin(ctx, "image1")
+
+	err := _c.Unpin(ctx, "image1", true)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(c.images))
+
+	err = _c.Unpin(ctx, "image1", true)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"image1:latest"}, c.images)
+
+	err = _c.Deinit(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, c.closed)
+
+	_, err = _c.Inventory(ctx)
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,202 @@
+//go:build linux
+
+package task
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	cgroupMagic  = 0x63677270 // CGROUP2_SUPER_MAGIC
+	cgroupPeriod = 100000
+	cgroupPerm   = 0755
+
+	cgroupControl = "cgroup.subtree_control"
+)
+
+type cgroup struct {
+	path string
+	file *os.File
+}
+
+// newCgroup creates the subtree of name under parent in root, and applies the resources to it
+func newCgroup(root, parent, name string, res Resources) (*cgroup, error) {
+	base := filepath.Join(root, parent)
+
+	if err := os.MkdirAll(base, cgroupPerm); err != nil {
+		return nil, errors.Wrap(err, "failed to make parent")
+	}
+
+	controllers := cgroupControllers(res)
+
+	if err := enableControllers(root, controllers); err != nil {
+		return nil, errors.Wrap(err, "failed to enable controllers in root")
+	}
+
+	if err := enableControllers(base, controllers); err != nil {
+		return nil, errors.Wrap(err, "failed to enable controllers in parent")
+	}
+
+	g := &cgroup{path: filepath.Join(base, name)}
+
+	if err := os.Mkdir(g.path, cgroupPerm); err != nil {
+		return nil, errors.Wrap(err, "failed to make cgroup")
+	}
+
+	limits := map[string]string{}
+
+	if res.MilliCPU > 0 {
+		limits["cpu.max"] = strconv.FormatInt(res.MilliCPU*cgroupPeriod/1000, 10) + " " + strconv.Itoa(cgroupPeriod)
+	}
+
+	if res.Memory > 0 {
+		limits["memory.max"] = strconv.FormatInt(res.Memory, 10)
+	}
+
+	if res.Pids > 0 {
+		limits["pids.max"] = strconv.FormatInt(res.Pids, 10)
+	}
+
+	if res.IOWeight > 0 {
+		limits["io.weight"] = "default " + strconv.FormatInt(res.IOWeight, 10)
+	}
+
+	for key, val := range limits {
+		if err := os.WriteFile(filepath.Join(g.path, key), []byte(val), cgroupPerm); err != nil {
+			_ = g.remove()
+			return nil, errors.Wrap(err, "failed to write "+key)
+		}
+	}
+
+	var stat syscall.Statfs_t
+
+	if err := syscall.Statfs(g.path, &stat); err == nil && stat.Type == cgroupMagic {
+		file, err := os.Open(g.path)
+		if err != nil {
+			_ = g.remove()
+			return nil, errors.Wrap(err, "failed to open cgroup")
+		}
+		g.file = file
+	}
+
+	return g, nil
+}
+
+// cgroupControllers returns the controllers required by the resources
+func cgroupControllers(res Resources) []string {
+	var buf []string
+
+	if res.MilliCPU > 0 {
+		buf = append(buf, "cpu")
+	}
+
+	if res.Memory > 0 {
+		buf = append(buf, "memory")
+	}
+
+	if res.Pids > 0 {
+		buf = append(buf, "pids")
+	}
+
+	if res.IOWeight > 0 {
+		buf = append(buf, "io")
+	}
+
+	return buf
+}
+
+// enableControllers enables the controllers in subtree of path one by one, since a write of several ones fails
+// as a whole. A controller enabled already is accepted even if the write is denied (e.g. in root of container).
+func enableControllers(path string, controllers []string) error {
+	name := filepath.Join(path, cgroupControl)
+
+	for _, item := range controllers {
+		err := os.WriteFile(name, []byte("+"+item), cgroupPerm)
+		if err == nil {
+			continue
+		}
+		if buf, e := os.ReadFile(name); e == nil && slices.Contains(strings.Fields(string(buf)), item) {
+			continue
+		}
+		return errors.Wrap(err, "failed to enable controller "+item)
+	}
+
+	return nil
+}
+
+// attach places the process in cgroup as it is cloned, which is only supported by cgroup2 filesystem
+func (g *cgroup) attach(c *exec.Cmd) {
+	if g.file == nil {
+		return
+	}
+
+	if c.SysProcAttr == nil {
+		c.SysProcAttr = &syscall.SysProcAttr{}
+	}
+
+	c.SysProcAttr.UseCgroupFD = true
+	c.SysProcAttr.CgroupFD = int(g.file.Fd())
+}
+
+// add moves the started process into cgroup if it is not attached
+func (g *cgroup) add(pid int) error {
+	if g.file != nil {
+		_ = g.file.Close()
+		g.file = nil
+		return nil
+	}
+
+	return os.WriteFile(filepath.Join(g.path, "cgroup.procs"), []byte(strconv.Itoa(pid)), cgroupPerm)
+}
+
+func (g *cgroup) stat(status *Status) {
+	if buf, err := os.ReadFile(filepath.Join(g.path, "memory.peak")); err == nil {
+		status.MemoryPeak, _ = strconv.ParseInt(strings.TrimSpace(string(buf)), 10, 64)
+	}
+
+	if val, ok := g.key("cpu.stat", "usage_usec"); ok {
+		status.CPUTime = val * 1000
+	}
+
+	if val, ok := g.key("memory.events", "oom_kill"); ok && val > 0 {
+		status.OOMKilled = true
+		status.Reason = ReasonOOMKilled
+	}
+}
+
+func (g *cgroup) key(name, key string) (int64, bool) {
+	buf, err := os.ReadFile(filepath.Join(g.path, name))
+	if err != nil {
+		return 0, false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == key {
+			val, err := strconv.ParseInt(fields[1], 10, 64)
+			return val, err == nil
+		}
+	}
+
+	return 0, false
+}
+
+func (g *cgroup) remove() error {
+	if g.file != nil {
+		_ = g.file.Close()
+	}
+
+	return os.RemoveAll(g.path)
+}
@@ -0,0 +1,85 @@
+//go:build linux
+
+package task
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCgroup(t *testing.T) {
+	root := t.TempDir()
+
+	res := Resources{
+		MilliCPU: 500,
+		Memory:   1048576,
+		Pids:     10,
+		IOWeight: 100,
+	}
+
+	g, err := newCgroup(root, cgroupParent, "task", res)
+	assert.Equal(t, nil, err)
+
+	helper := func(name string) string {
+		buf, _ := os.ReadFile(filepath.Join(root, cgroupParent, "task", name))
+		return string(buf)
+	}
+
+	assert.Equal(t, "50000 100000", helper("cpu.max"))
+	assert.Equal(t, "1048576", helper("memory.max"))
+	assert.Equal(t, "10", helper("pids.max"))
+	assert.Equal(t, "default 100", helper("io.weight"))
+
+	err = g.add(1000)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "1000", helper("cgroup.procs"))
+
+	_ = os.WriteFile(filepath.Join(g.path, "memory.peak"), []byte("2048\n"), cgroupPerm)
+	_ = os.WriteFile(filepath.Join(g.path, "cpu.stat"), []byte("usage_usec 1500\nuser_usec 1000\n"), cgroupPerm)
+	_ = os.WriteFile(filepath.Join(g.path, "memory.events"), []byte("low 0\noom 1\noom_kill 1\n"), cgroupPerm)
+
+	status := Status{Reason: ReasonSignaled}
+	g.stat(&status)
+	assert.Equal(t, int64(2048), status.MemoryPeak)
+	assert.Equal(t, int64(1500000), status.CPUTime)
+	assert.Equal(t, true, status.OOMKilled)
+	assert.Equal(t, ReasonOOMKilled, status.Reason)
+
+	err = g.remove()
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(g.path)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	_ = os.WriteFile(filepath.Join(root, "invalid"), nil, cgroupPerm)
+	_, err = newCgroup(filepath.Join(root, "invalid"), cgroupParent, "task", res)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestCgroupControllers(t *testing.T) {
+	assert.Equal(t, []string{"cpu", "memory", "pids", "io"},
+		cgroupControllers(Resources{MilliCPU: 500, Memory: 1048576, Pids: 10, IOWeight: 100}))
+	assert.Equal(t, []string{"pids"}, cgroupControllers(Resources{Pids: 10}))
+
+	root := t.TempDir()
+
+	err := enableControllers(root, []string{"pids"})
+	assert.Equal(t, nil, err)
+
+	buf, _ := os.ReadFile(filepath.Join(root, cgroupControl))
+	assert.Equal(t, "+pids", string(buf))
+
+	// Controller which is neither enabled nor available fails
+	root = t.TempDir()
+	_ = os.Mkdir(filepath.Join(root, cgroupControl), cgroupPerm)
+
+	err = enableControllers(root, []string{"memory"})
+	assert.NotEqual(t, nil, err)
+	assert.Contains(t, err.Error(), "memory")
+
+	_, err = newCgroup(root, cgroupParent, "task", Resources{Memory: 1048576})
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+
+package task
+
+import (
+	"os/exec"
+
+	"github.com/pkg/errors"
+)
+
+type cgroup struct{}
+
+func newCgroup(_, _, _ string, _ Resources) (*cgroup, error) {
+	return nil, errors.New("cgroup not supported")
+}
+
+func (g *cgroup) attach(_ *exec.Cmd) {}
+
+func (g *cgroup) add(_ int) error {
+	return nil
+}
+
+func (g *cgroup) stat(_ *Status) {}
+
+func (g *cgroup) remove() error {
+	return nil
+}
@@ -0,0 +1,542 @@
+package task
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/docker/docker/client"
+	"github.com/docker/docker/pkg/jsonmessage"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/pkg/errors"
+)
+
+// dockerClient is the part of docker client used by executor, which is replaced in tests
+type dockerClient interface {
+	client.ContainerAPIClient
+	client.ImageAPIClient
+	Close() error
+}
+
+type docker struct {
+	cfg    *Config
+	lang   Language
+	spec   Spec
+	client dockerClient
+	mu     sync.Mutex
+	id     string
+	pinned bool
+	stdout io.Reader
+	stderr io.Reader
+}
+
+func newDocker(_ context.Context, cfg *Config) Executor {
+	return &docker{
+		cfg: cfg,
+	}
+}
+
+// Prepare checks container options of language and pulls the artifact image in pull policy
+func (d *docker) Prepare(ctx context.Context, lang Language, progress func(Progress)) error {
+	d.lang = lang
+
+	if err := d.checkContainer(); err != nil {
+		return errors.Wrap(err, "failed to check container")
+	}
+
+	if d.client == nil {
+		c, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+		if err != nil {
+			return errors.Wrap(err, "failed to new client")
+		}
+		d.client = c
+	}
+
+	// Image is pinned in cache until cleanup, which is never evicted by other tasks
+	if d.cfg.Cache != nil {
+		d.cfg.Cache.Pin(ctx, d.lang.Artifact.Image)
+		d.pinned = true
+	}
+
+	if err := d.prepareImage(ctx, progress); err != nil {
+		d.unpinImage(ctx, false)
+		return err
+	}
+
+	return nil
+}
+
+// prepareImage pulls the artifact image in pull policy, and evicts images in cache after pulled
+func (d *docker) prepareImage(ctx context.Context, progress func(Progress)) error {
+	if d.lang.Artifact.PullPolicy != PullAlways && d.lang.Artifact.PullPolicy != "" {
+		if _, _, err := d.client.ImageInspectWithRaw(ctx, d.lang.Artifact.Image); err == nil {
+			return nil
+		} else if d.lang.Artifact.PullPolicy == PullNever {
+			return errors.Wrap(err, "failed to inspect image")
+		}
+	}
+
+	auth, err := d.registryAuth(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get credential")
+	}
+
+	if err := d.pullImage(ctx, d.lang.Artifact.Image, auth, progress); err != nil {
+		return errors.Wrap(err, "failed to pull image")
+	}
+
+	if d.cfg.Cache != nil {
+		_ = d.cfg.Cache.Evict(ctx)
+	}
+
+	return nil
+}
+
+// Start runs file in container, and workspace is mounted as target instead of the directory of file.
+// Runner env in Environ is passed to container only if selected in policy, and it is nil otherwise.
+func (d *docker) Start(ctx context.Context, spec Spec) error {
+	d.spec = spec
+
+	env := append(append([]string{}, spec.Environ...), spec.Env...)
+	name := []string{filepath.Join(string(os.PathSeparator), langTarget, filepath.Base(spec.File))}
+	source := filepath.Dir(spec.File)
+
+	if spec.Dir != "" {
+		rel, err := filepath.Rel(spec.Dir, spec.File)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+			return errors.New("invalid file")
+		}
+		name = []string{filepath.Join(string(os.PathSeparator), langTarget, rel)}
+		source = spec.Dir
+		env = append(env, EnvWorkspace+"="+filepath.Join(string(os.PathSeparator), langTarget))
+		if spec.Output != "" {
+			env = append(env, EnvOutput+"="+filepath.Join(string(os.PathSeparator), langTarget, outputName))
+		}
+		if err := d.ownWorkspace(spec.Dir); err != nil {
+			return errors.Wrap(err, "failed to own workspace")
+		}
+	}
+
+	id, stdout, stderr, err := d.runContainer(ctx, d.lang.Artifact.Image, env, name, source, langTarget)
+	if err != nil {
+		return errors.Wrap(err, "failed to run container")
+	}
+
+	d.mu.Lock()
+	d.id, d.stdout, d.stderr = id, stdout, stderr
+	d.mu.Unlock()
+
+	return nil
+}
+
+func (d *docker) Stream(_ context.Context) (stdout, stderr io.Reader) {
+	return d.stdout, d.stderr
+}
+
+// Wait waits for container to exit, and the container is removed as it exits
+func (d *docker) Wait(ctx context.Context) Status {
+	d.mu.Lock()
+	id := d.id
+	d.mu.Unlock()
+
+	if id == "" {
+		return Status{}
+	}
+
+	status, err := d.waitContainer(ctx, id)
+	if err != nil {
+		// Status of container is unknown, and it is never reported in success
+		status = Status{ExitCode: -1, Reason: ReasonError}
+	}
+
+	_ = d.removeContainer(context.WithoutCancel(ctx), id)
+
+	return status
+}
+
+// Kill stops container with grace
+func (d *docker) Kill(ctx context.Context, grace time.Duration) error {
+	d.mu.Lock()
+	id := d.id
+	d.mu.Unlock()
+
+	if id == "" {
+		return nil
+	}
+
+	timeout := int(grace.Seconds())
+
+	if err := d.client.ContainerStop(ctx, id, container.StopOptions{Timeout: &timeout}); err != nil {
+		return errors.Wrap(err, "failed to stop container")
+	}
+
+	return nil
+}
+
+func (d *docker) Resize(ctx context.Context, rows, cols uint16) error {
+	d.mu.Lock()
+	id := d.id
+	d.mu.Unlock()
+
+	if id == "" || !d.spec.Tty {
+		return nil
+	}
+
+	if err := d.client.ContainerResize(ctx, id, container.ResizeOptions{Height: uint(rows),
+		Width: uint(cols)}); err != nil {
+		return errors.Wrap(err, "failed to resize container")
+	}
+
+	return nil
+}
+
+func (d *docker) Cleanup(ctx context.Context) error {
+	if d.client == nil {
+		return nil
+	}
+
+	if d.pinned {
+		d.unpinImage(ctx, d.lang.Artifact.Cleanup)
+	} else if d.lang.Artifact.Cleanup {
+		_ = d.removeImage(ctx, d.lang.Artifact.Image)
+	}
+
+	_ = d.client.Close()
+
+	return nil
+}
+
+// registryAuth returns user and pass of artifact if set, otherwise the credential in runner config
+func (d *docker) registryAuth(ctx context.Context) (registry.AuthConfig, error) {
+	if d.lang.Artifact.User != "" && d.lang.Artifact.Pass != "" {
+		return registry.AuthConfig{Username: d.lang.Artifact.User, Password: d.lang.Artifact.Pass}, nil
+	}
+
+	return registryAuth(ctx, d.cfg.Config.Spec.Registry, d.lang.Artifact.Credential, d.lang.Artifact.Image)
+}
+
+// pullImage pulls image and reports the progress of layers in the JSON messages of pull
+func (d *docker) pullImage(ctx context.Context, name string, auth registry.AuthConfig,
+	progress func(Progress)) error {
+	options := image.PullOptions{}
+
+	if (auth.Username != "" && auth.Password != "") || auth.IdentityToken != "" {
+		encodedJSON, _ := json.Marshal(auth)
+		options.RegistryAuth = base64.URLEncoding.EncodeToString(encodedJSON)
+	}
+
+	out, err := d.client.ImagePull(ctx, name, options)
+	if err != nil {
+		return errors.Wrap(err, "failed to pull image")
+	}
+
+	defer func(out io.ReadCloser) {
+		_ = out.Close()
+	}(out)
+
+	decoder := json.NewDecoder(out)
+
+	for {
+		var msg jsonmessage.JSONMessage
+		if err := decoder.Decode(&msg); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return errors.Wrap(err, "failed to decode")
+		}
+		if msg.Error != nil {
+			return errors.New(msg.Error.Message)
+		}
+		if msg.ErrorMessage != "" {
+			return errors.New(msg.ErrorMessage)
+		}
+		p := Progress{
+			ID:     msg.ID,
+			Status: msg.Status,
+		}
+		if msg.Progress != nil {
+			p.Current, p.Total = msg.Progress.Current, msg.Progress.Total
+		}
+		progress(p)
+	}
+
+	return nil
+}
+
+func (d *docker) runContainer(ctx context.Context, name string, env, cmd []string, source, target string) (id string,
+	stdout, stderr io.Reader, err error) {
+	_config, hostConfig := d.buildContainer(name, env, cmd, source, target)
+
+	resp, err := d.client.ContainerCreate(ctx, _config, hostConfig, &network.NetworkingConfig{},
+		nil, "")
+	if err != nil {
+		return "", nil, nil, errors.Wrap(err, "failed to create container")
+	}
+
+	if d.spec.Stdin != nil {
+		if err = d.attachStdin(ctx, resp.ID, d.spec.Stdin); err != nil {
+			_ = d.removeContainer(ctx, resp.ID)
+			return "", nil, nil, errors.Wrap(err, "failed to attach container")
+		}
+	}
+
+	if err = d.client.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
+		_ = d.removeContainer(ctx, resp.ID)
+		return "", nil, nil, errors.Wrap(err, "failed to start container")
+	}
+
+	reader, err := d.client.ContainerLogs(ctx, resp.ID, container.LogsOptions{ShowStdout: true, ShowStderr: true,
+		Follow: true})
+	if err != nil {
+		_ = d.removeContainer(ctx, resp.ID)
+		return "", nil, nil, errors.Wrap(err, "failed to log container")
+	}
+
+	if d.spec.Tty {
+		stdout = d.drain(reader)
+	} else {
+		stdout, stderr = d.demux(reader)
+	}
+
+	return resp.ID, stdout, stderr, nil
+}
+
+// ownWorkspace changes the owner of workspace into user of container, so that the mount is writable in it.
+// User in name is rejected except root, since it is resolved only in the image.
+func (d *docker) ownWorkspace(dir string) error {
+	user := d.lang.Container.User
+	if user == "" || user == "root" || strings.HasPrefix(user, "root:") {
+		return nil
+	}
+
+	name, group, found := strings.Cut(user, ":")
+
+	uid, err := strconv.Atoi(name)
+	if err != nil {
+		return errors.New("uid required in user")
+	}
+
+	gid := -1
+
+	if found {
+		if gid, err = strconv.Atoi(group); err != nil {
+			return errors.New("gid required in user")
+		}
+	}
+
+	return chownAll(dir, uid, gid)
+}
+
+// attachStdin copies stdin into container until stdin is closed or the container exits
+func (d *docker) attachStdin(ctx context.Context, id string, stdin io.Reader) error {
+	resp, err := d.client.ContainerAttach(ctx, id, container.AttachOptions{Stream: true, Stdin: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to attach")
+	}
+
+	go func() {
+		_, _ = io.Copy(resp.Conn, stdin)
+		_ = resp.CloseWrite()
+		resp.Close()
+	}()
+
+	return nil
+}
+
+func (d *docker) checkContainer() error {
+	switch d.lang.Artifact.PullPolicy {
+	case "", PullAlways, PullIfNotPresent, PullNever:
+	default:
+		return errors.New("invalid pull policy")
+	}
+
+	switch d.lang.Container.Network {
+	case "", networkBridge, networkHost, networkNone:
+	default:
+		return errors.New("invalid network")
+	}
+
+	for _, item := range d.lang.Container.Tmpfs {
+		if !filepath.IsAbs(item.Target) {
+			return errors.New("invalid tmpfs")
+		}
+	}
+
+	return nil
+}
+
+func (d *docker) buildContainer(name string, env, cmd []string, source, target string) (*container.Config,
+	*container.HostConfig) {
+	_config := &container.Config{
+		Image: name,
+		Env:   append(env, d.lang.Container.Env...),
+		Cmd:   cmd,
+		Tty:   d.spec.Tty,
+		User:  d.lang.Container.User,
+	}
+
+	if d.spec.Stdin != nil {
+		_config.AttachStdin = true
+		_config.OpenStdin = true
+		_config.StdinOnce = true
+	}
+
+	hostConfig := &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:   mount.TypeBind,
+				Source: source,
+				Target: target,
+			},
+		},
+		NetworkMode:    container.NetworkMode(d.lang.Container.Network),
+		ReadonlyRootfs: d.lang.Container.ReadOnly,
+		Resources: container.Resources{
+			NanoCPUs: d.lang.Container.NanoCPUs,
+			Memory:   d.lang.Container.Memory,
+		},
+	}
+
+	if d.spec.Tty {
+		hostConfig.ConsoleSize = [2]uint{uint(d.spec.Rows), uint(d.spec.Cols)}
+	}
+
+	for _, item := range d.lang.Container.Tmpfs {
+		hostConfig.Mounts = append(hostConfig.Mounts, mount.Mount{
+			Type:   mount.TypeTmpfs,
+			Target: item.Target,
+			TmpfsOptions: &mount.TmpfsOptions{
+				SizeBytes: item.Size,
+			},
+		})
+	}
+
+	return _config, hostConfig
+}
+
+func (d *docker) waitContainer(ctx context.Context, id string) (Status, error) {
+	statusCh, errCh := d.client.ContainerWait(ctx, id, container.WaitConditionNotRunning)
+	select {
+	case err := <-errCh:
+		if err != nil {
+			return Status{}, errors.Wrap(err, "failed to wait container")
+		}
+	case <-statusCh:
+	}
+
+	status, err := d.containerStatus(ctx, id)
+	if err != nil {
+		return Status{}, errors.Wrap(err, "failed to inspect container")
+	}
+
+	return status, nil
+}
+
+// demux splits the log of container into stdout and stderr with the stdcopy framing
+func (d *docker) demux(reader io.ReadCloser) (stdout, stderr *bufio.Reader) {
+	stdoutReader, stdoutWriter := io.Pipe()
+	stderrReader, stderrWriter := io.Pipe()
+
+	go func() {
+		_, err := stdcopy.StdCopy(stdoutWriter, stderrWriter, reader)
+		_ = reader.Close()
+		_ = stdoutWriter.CloseWithError(err)
+		_ = stderrWriter.CloseWithError(err)
+	}()
+
+	return bufio.NewReader(stdoutReader), bufio.NewReader(stderrReader)
+}
+
+// drain reads the output of container in tty, which is not multiplexed, and closes reader once drained
+func (d *docker) drain(reader io.ReadCloser) *bufio.Reader {
+	pipeReader, pipeWriter := io.Pipe()
+
+	go func() {
+		_, err := io.Copy(pipeWriter, reader)
+		_ = reader.Close()
+		_ = pipeWriter.CloseWithError(err)
+	}()
+
+	return bufio.NewReader(pipeReader)
+}
+
+func (d *docker) containerStatus(ctx context.Context, id string) (Status, error) {
+	info, err := d.client.ContainerInspect(ctx, id)
+	if err != nil {
+		return Status{}, errors.Wrap(err, "failed to inspect")
+	}
+
+	if info.ContainerJSONBase == nil || info.State == nil {
+		return Status{}, errors.New("invalid state")
+	}
+
+	start, _ := time.Parse(time.RFC3339Nano, info.State.StartedAt)
+	end, _ := time.Parse(time.RFC3339Nano, info.State.FinishedAt)
+
+	status := Status{
+		ExitCode:  int64(info.State.ExitCode),
+		OOMKilled: info.State.OOMKilled,
+		Reason:    ReasonExited,
+		StartTime: start.UnixNano(),
+		EndTime:   end.UnixNano(),
+		Duration:  end.Sub(start).Nanoseconds(),
+	}
+
+	// Docker reports a container killed by signal N as exit code 128+N
+	if status.ExitCode > signalBase {
+		status.Signal = syscall.Signal(status.ExitCode - signalBase).String()
+		status.Reason = ReasonSignaled
+	}
+
+	if status.OOMKilled {
+		status.Reason = ReasonOOMKilled
+	}
+
+	return status, nil
+}
+
+func (d *docker) removeContainer(ctx context.Context, id string) error {
+	options := container.RemoveOptions{
+		RemoveVolumes: true,
+		RemoveLinks:   true,
+		Force:         true,
+	}
+
+	_ = d.client.ContainerRemove(ctx, id, options)
+
+	return nil
+}
+
+// removeImage removes image without force, which is kept if it is used by other containers
+func (d *docker) removeImage(ctx context.Context, id string) error {
+	options := image.RemoveOptions{
+		PruneChildren: true,
+	}
+
+	_, _ = d.client.ImageRemove(ctx, id, options)
+
+	return nil
+}
+
+func (d *docker) unpinImage(ctx context.Context, cleanup bool) {
+	if !d.pinned {
+		return
+	}
+
+	_ = d.cfg.Cache.Unpin(ctx, d.lang.Artifact.Image, cleanup)
+	d.pinned = false
+}
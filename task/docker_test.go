@@ -0,0 +1,543 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/pkg/stdcopy"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pipego/runner/config"
+)
+
+type fakeClient struct {
+	dockerClient
+	auth     string
+	pull     string
+	pulled   bool
+	present  bool
+	config   *container.Config
+	host     *container.HostConfig
+	logs     []byte
+	state    *types.ContainerState
+	inspect  error
+	started  bool
+	stopped  *int
+	sizes    []container.ResizeOptions
+	removed  []string
+	images   []string
+	list     []image.Summary
+	closed   bool
+	drained  bool
+	finished chan container.WaitResponse
+}
+
+type fakeLogs struct {
+	*bytes.Reader
+	client *fakeClient
+}
+
+func (l *fakeLogs) Close() error {
+	l.client.drained = true
+	return nil
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		finished: make(chan container.WaitResponse, 1),
+	}
+}
+
+func (c *fakeClient) ImagePull(_ context.Context, _ string, options image.PullOptions) (io.ReadCloser, error) {
+	c.auth = options.RegistryAuth
+	c.pulled = true
+	return io.NopCloser(strings.NewReader(c.pull)), nil
+}
+
+func (c *fakeClient) ImageInspectWithRaw(_ context.Context, _ string) (types.ImageInspect, []byte, error) {
+	if !c.present {
+		return types.ImageInspect{}, nil, errors.New("image not found")
+	}
+	return types.ImageInspect{}, nil, nil
+}
+
+func (c *fakeClient) ImageRemove(_ context.Context, name string, _ image.RemoveOptions) ([]image.DeleteResponse,
+	error) {
+	c.images = append(c.images, name)
+	return nil, nil
+}
+
+func (c *fakeClient) ImageList(_ context.Context, _ image.ListOptions) ([]image.Summary, error) {
+	return c.list, nil
+}
+
+func (c *fakeClient) ImagesPrune(_ context.Context, _ filters.Args) (types.ImagesPruneReport, error) {
+	return types.ImagesPruneReport{}, nil
+}
+
+func (c *fakeClient) ContainerCreate(_ context.Context, config *container.Config, host *container.HostConfig,
+	_ *network.NetworkingConfig, _ *ocispec.Platform, _ string) (container.CreateResponse, error) {
+	c.config, c.host = config, host
+	return container.CreateResponse{ID: "id"}, nil
+}
+
+func (c *fakeClient) ContainerStart(_ context.Context, _ string, _ container.StartOptions) error {
+	c.started = true
+	return nil
+}
+
+func (c *fakeClient) ContainerLogs(_ context.Context, _ string, _ container.LogsOptions) (io.ReadCloser, error) {
+	return &fakeLogs{Reader: bytes.NewReader(c.logs), client: c}, nil
+}
+
+func (c *fakeClient) ContainerWait(_ context.Context, _ string, _ container.WaitCondition) (
+	<-chan container.WaitResponse, <-chan error) {
+	return c.finished, make(chan error)
+}
+
+func (c *fakeClient) ContainerInspect(_ context.Context, _ string) (types.ContainerJSON, error) {
+	if c.inspect != nil {
+		return types.ContainerJSON{}, c.inspect
+	}
+	return types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			State: c.state,
+		},
+	}, nil
+}
+
+func (c *fakeClient) ContainerStop(_ context.Context, _ string, options container.StopOptions) error {
+	c.stopped = options.Timeout
+	c.finished <- container.WaitResponse{}
+	return nil
+}
+
+func (c *fakeClient) ContainerResize(_ context.Context, _ string, options container.ResizeOptions) error {
+	c.sizes = append(c.sizes, options)
+	return nil
+}
+
+func (c *fakeClient) ContainerRemove(_ context.Context, id string, _ container.RemoveOptions) error {
+	c.removed = append(c.removed, id)
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestDockerRun(t *testing.T) {
+	var buf bytes.Buffer
+
+	_, _ = stdcopy.NewStdWriter(&buf, stdcopy.Stdout).Write([]byte("out1\n"))
+	_, _ = stdcopy.NewStdWriter(&buf, stdcopy.Stderr).Write([]byte("err1\n"))
+
+	c := newFakeClient()
+	c.logs = buf.Bytes()
+	c.state = &types.ContainerState{
+		ExitCode:   3,
+		StartedAt:  "2006-01-02T15:04:05Z",
+		FinishedAt: "2006-01-02T15:04:10Z",
+	}
+	c.finished <- container.WaitResponse{StatusCode: 3}
+
+	d := &docker{
+		cfg:    DefaultConfig(),
+		client: c,
+	}
+
+	ctx := context.Background()
+
+	lang := Language{
+		Name: "groovy",
+		Artifact: Artifact{
+			Image:   "craftslab/groovy:latest",
+			User:    "user",
+			Pass:    "pass",
+			Cleanup: true,
+		},
+	}
+
+	err := d.Prepare(ctx, lang, func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", c.auth)
+
+	err = d.Start(ctx, Spec{Dir: "/path/to/workspace", File: "/path/to/file"})
+	assert.NotEqual(t, nil, err)
+
+	err = d.Start(ctx, Spec{Dir: "/path/to/workspace", File: "/path/to/workspace/src/main.groovy",
+		Environ: []string{"PATH=/bin"}, Env: []string{"ENV1=task1"}, Output: "/path/to/workspace/" + outputName})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, c.started)
+	assert.Equal(t, []string{"PATH=/bin", "ENV1=task1", EnvWorkspace + "=/workspace",
+		EnvOutput + "=/workspace/" + outputName}, c.config.Env)
+	assert.Equal(t, []string{"/workspace/src/main.groovy"}, []string(c.config.Cmd))
+	assert.Equal(t, "/path/to/workspace", c.host.Mounts[0].Source)
+	assert.Equal(t, false, c.config.OpenStdin)
+
+	stdout, stderr := d.Stream(ctx)
+
+	g := errgroup.Group{}
+
+	g.Go(func() error {
+		out, err := io.ReadAll(stdout)
+		assert.Equal(t, "out1\n", string(out))
+		return err
+	})
+
+	g.Go(func() error {
+		out, err := io.ReadAll(stderr)
+		assert.Equal(t, "err1\n", string(out))
+		return err
+	})
+
+	assert.Equal(t, nil, g.Wait())
+
+	status := d.Wait(ctx)
+	assert.Equal(t, int64(3), status.ExitCode)
+	assert.Equal(t, ReasonExited, status.Reason)
+	assert.Equal(t, int64(5*time.Second), status.Duration)
+	assert.Equal(t, []string{"id"}, c.removed)
+
+	err = d.Cleanup(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"craftslab/groovy:latest"}, c.images)
+	assert.Equal(t, true, c.closed)
+}
+
+func TestDockerKill(t *testing.T) {
+	c := newFakeClient()
+	c.state = &types.ContainerState{
+		ExitCode: 137,
+	}
+
+	d := &docker{
+		cfg:    DefaultConfig(),
+		client: c,
+	}
+
+	ctx := context.Background()
+
+	err := d.Prepare(ctx, Language{Name: "groovy", Artifact: Artifact{Image: "craftslab/groovy:latest"}},
+		func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", c.auth)
+
+	err = d.Kill(ctx, time.Second)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, (*int)(nil), c.stopped)
+
+	err = d.Start(ctx, Spec{File: "/path/to/file", Tty: true, Rows: 24, Cols: 80})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(c.config.Env))
+	assert.Equal(t, true, c.config.Tty)
+	assert.Equal(t, [2]uint{24, 80}, c.host.ConsoleSize)
+
+	// Logs of tty are closed once drained
+	stdout, stderr := d.Stream(ctx)
+	assert.Equal(t, nil, stderr)
+	_, err = io.ReadAll(stdout)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, c.drained)
+
+	err = d.Resize(ctx, 40, 120)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []container.ResizeOptions{{Height: 40, Width: 120}}, c.sizes)
+
+	err = d.Kill(ctx, 10*time.Second)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 10, *c.stopped)
+
+	status := d.Wait(ctx)
+	assert.Equal(t, int64(137), status.ExitCode)
+	assert.Equal(t, "killed", status.Signal)
+	assert.Equal(t, ReasonSignaled, status.Reason)
+
+	err = d.Cleanup(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(c.images))
+}
+
+func TestDockerWaitError(t *testing.T) {
+	c := newFakeClient()
+	c.inspect = errors.New("no such container")
+	c.finished <- container.WaitResponse{}
+
+	d := &docker{
+		cfg:    DefaultConfig(),
+		client: c,
+	}
+
+	ctx := context.Background()
+
+	err := d.Prepare(ctx, Language{Name: "groovy", Artifact: Artifact{Image: "craftslab/groovy:latest"}},
+		func(Progress) {})
+	assert.Equal(t, nil, err)
+
+	err = d.Start(ctx, Spec{File: "/path/to/file"})
+	assert.Equal(t, nil, err)
+
+	// Container removed meanwhile is never reported in success
+	status := d.Wait(ctx)
+	assert.Equal(t, int64(-1), status.ExitCode)
+	assert.Equal(t, ReasonError, status.Reason)
+	assert.Equal(t, []string{"id"}, c.removed)
+}
+
+func TestDockerWorkspace(t *testing.T) {
+	d := &docker{
+		cfg: DefaultConfig(),
+	}
+
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "file"), nil, 0644)
+
+	err := d.ownWorkspace(dir)
+	assert.Equal(t, nil, err)
+
+	d.lang.Container.User = "root"
+	err = d.ownWorkspace(dir)
+	assert.Equal(t, nil, err)
+
+	d.lang.Container.User = strconv.Itoa(os.Getuid()) + ":" + strconv.Itoa(os.Getgid())
+	err = d.ownWorkspace(dir)
+	assert.Equal(t, nil, err)
+
+	// User in name is resolved only in the image
+	d.lang.Container.User = "nobody"
+	err = d.ownWorkspace(dir)
+	assert.NotEqual(t, nil, err)
+
+	d.lang.Container.User = "1000:users"
+	err = d.ownWorkspace(dir)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestDockerPrepare(t *testing.T) {
+	d := &docker{
+		cfg:    DefaultConfig(),
+		client: newFakeClient(),
+	}
+
+	ctx := context.Background()
+
+	err := d.Prepare(ctx, Language{Name: "groovy", Container: Container{Network: "invalid"}}, func(Progress) {})
+	assert.NotEqual(t, nil, err)
+
+	err = d.Prepare(ctx, Language{Name: "groovy", Artifact: Artifact{PullPolicy: "invalid"}}, func(Progress) {})
+	assert.NotEqual(t, nil, err)
+
+	c := newFakeClient()
+	d.client = c
+	d.cfg.Config.Spec.Registry.Credentials = []config.Credential{
+		{Name: "hub", Host: "docker.io", User: "user", Pass: "pass"},
+	}
+
+	lang := Language{Name: "groovy", Artifact: Artifact{Image: "craftslab/groovy:latest", Credential: "hub"}}
+
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, "", c.auth)
+
+	lang.Artifact.Credential = "invalid"
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.NotEqual(t, nil, err)
+}
+
+func TestDockerPull(t *testing.T) {
+	var progress []Progress
+
+	c := newFakeClient()
+	c.pull = `{"status":"Pulling fs layer","id":"layer1"}
+{"status":"Downloading","id":"layer1","progressDetail":{"current":1024,"total":2048}}
+`
+
+	d := &docker{
+		cfg:    DefaultConfig(),
+		client: c,
+	}
+
+	ctx := context.Background()
+
+	lang := Language{
+		Name: "groovy",
+		Artifact: Artifact{
+			Image: "craftslab/groovy:latest",
+		},
+	}
+
+	err := d.Prepare(ctx, lang, func(p Progress) {
+		progress = append(progress, p)
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, c.pulled)
+	assert.Equal(t, []Progress{
+		{ID: "layer1", Status: "Pulling fs layer"},
+		{ID: "layer1", Status: "Downloading", Current: 1024, Total: 2048},
+	}, progress)
+
+	c.pull = `{"error":"pull access denied"}`
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.NotEqual(t, nil, err)
+
+	c.pulled = false
+	lang.Artifact.PullPolicy = PullNever
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, false, c.pulled)
+
+	c.present = true
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, c.pulled)
+
+	lang.Artifact.PullPolicy = PullIfNotPresent
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, false, c.pulled)
+
+	c.present = false
+	c.pull = ""
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, c.pulled)
+}
+
+func TestDockerCache(t *testing.T) {
+	c := newFakeClient()
+	_c := initCache(c, 0)
+
+	cfg := DefaultConfig()
+	cfg.Cache = _c
+
+	d := &docker{
+		cfg:    cfg,
+		client: c,
+	}
+
+	ctx := context.Background()
+
+	lang := Language{
+		Name: "groovy",
+		Artifact: Artifact{
+			Image:      "craftslab/groovy:latest",
+			Cleanup:    true,
+			PullPolicy: PullNever,
+		},
+	}
+
+	err := d.Prepare(ctx, lang, func(Progress) {})
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, false, d.pinned)
+	assert.Equal(t, 0, len(_c.pinned))
+
+	lang.Artifact.PullPolicy = PullAlways
+
+	err = d.Prepare(ctx, lang, func(Progress) {})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(1), _c.pinned["craftslab/groovy:latest"])
+
+	err = d.Cleanup(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(_c.pinned))
+	assert.Equal(t, []string{"craftslab/groovy:latest"}, c.images)
+}
+
+func TestDemux(t *testing.T) {
+	var buf bytes.Buffer
+
+	d := &docker{
+		cfg: DefaultConfig(),
+	}
+
+	_, _ = stdcopy.NewStdWriter(&buf, stdcopy.Stdout).Write([]byte("out1\n"))
+	_, _ = stdcopy.NewStdWriter(&buf, stdcopy.Stderr).Write([]byte("err1\n"))
+	_, _ = stdcopy.NewStdWriter(&buf, stdcopy.Stdout).Write([]byte("out2\n"))
+
+	stdout, stderr := d.demux(io.NopCloser(&buf))
+
+	g := errgroup.Group{}
+
+	g.Go(func() error {
+		out, err := io.ReadAll(stdout)
+		assert.Equal(t, "out1\nout2\n", string(out))
+		return err
+	})
+
+	g.Go(func() error {
+		out, err := io.ReadAll(stderr)
+		assert.Equal(t, "err1\n", string(out))
+		return err
+	})
+
+	assert.Equal(t, nil, g.Wait())
+}
+
+func TestBuildContainer(t *testing.T) {
+	d := &docker{
+		cfg: DefaultConfig(),
+	}
+
+	d.lang = Language{
+		Name: "groovy",
+		Artifact: Artifact{
+			Image: "craftslab/groovy:latest",
+		},
+	}
+	d.lang.Container = Container{
+		NanoCPUs: 1000000000,
+		Memory:   1073741824,
+		Network:  networkNone,
+		User:     "1000:1000",
+		ReadOnly: true,
+		Env:      []string{"ENV3=task3"},
+		Tmpfs: []Tmpfs{
+			{
+				Target: "/tmp",
+				Size:   67108864,
+			},
+		},
+	}
+
+	err := d.checkContainer()
+	assert.Equal(t, nil, err)
+
+	env := []string{"ENV1=task1", "ENV2=task2"}
+	cmd := []string{filepath.Join(string(os.PathSeparator), langTarget, "jenkinsfile")}
+
+	_config, hostConfig := d.buildContainer(d.lang.Artifact.Image, env, cmd, "/path/to/source", langTarget)
+	assert.Equal(t, "1000:1000", _config.User)
+	assert.Equal(t, []string{"ENV1=task1", "ENV2=task2", "ENV3=task3"}, []string(_config.Env))
+	assert.Equal(t, int64(1000000000), hostConfig.NanoCPUs)
+	assert.Equal(t, int64(1073741824), hostConfig.Memory)
+	assert.Equal(t, networkNone, string(hostConfig.NetworkMode))
+	assert.Equal(t, true, hostConfig.ReadonlyRootfs)
+	assert.Equal(t, 2, len(hostConfig.Mounts))
+	assert.Equal(t, "/tmp", hostConfig.Mounts[1].Target)
+	assert.Equal(t, int64(67108864), hostConfig.Mounts[1].TmpfsOptions.SizeBytes)
+
+	d.lang.Container.Network = "invalid"
+	err = d.checkContainer()
+	assert.NotEqual(t, nil, err)
+
+	d.lang.Container.Network = networkHost
+	d.lang.Container.Tmpfs = []Tmpfs{{Target: "tmp"}}
+	err = d.checkContainer()
+	assert.NotEqual(t, nil, err)
+}
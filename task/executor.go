@@ -0,0 +1,80 @@
+package task
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ExecutorBash   = "bash"
+	ExecutorDocker = "docker"
+)
+
+// Executor runs the process of task in backend, which is called in order of
+// Prepare, Start, Stream, Wait and Cleanup. Kill and Resize are called meanwhile.
+// The progress of Prepare is reported with the callback.
+type Executor interface {
+	Prepare(context.Context, Language, func(Progress)) error
+	Start(context.Context, Spec) error
+	Stream(context.Context) (io.Reader, io.Reader)
+	Wait(context.Context) Status
+	Kill(context.Context, time.Duration) error
+	Resize(context.Context, uint16, uint16) error
+	Cleanup(context.Context) error
+}
+
+// Spec is the process to start in executor. Environ is the variables of runner selected in policy, which are
+// inherited in bash and not passed to container if nil, and Env is the variables of task in addition.
+type Spec struct {
+	Dir       string
+	Environ   []string
+	Env       []string
+	Cmd       []string
+	File      string
+	Output    string
+	Resources Resources
+	Stdin     *os.File
+	Tty       bool
+	Rows      uint16
+	Cols      uint16
+}
+
+type NewExecutor func(context.Context, *Config) Executor
+
+var (
+	executorMu = sync.RWMutex{}
+	executors  = map[string]NewExecutor{
+		ExecutorBash:   newBash,
+		ExecutorDocker: newDocker,
+	}
+)
+
+// Register adds executor in name of language, which replaces the registered one in the same name
+func Register(name string, fn NewExecutor) {
+	executorMu.Lock()
+	defer executorMu.Unlock()
+
+	executors[name] = fn
+}
+
+// lookup returns the executor of language, and the languages not registered are run in docker
+func lookup(ctx context.Context, cfg *Config, name string) (Executor, error) {
+	executorMu.RLock()
+	defer executorMu.RUnlock()
+
+	fn, ok := executors[name]
+	if !ok {
+		fn, ok = executors[ExecutorDocker]
+	}
+
+	if !ok || fn == nil {
+		return nil, errors.New("executor not found")
+	}
+
+	return fn(ctx, cfg), nil
+}
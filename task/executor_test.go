@@ -0,0 +1,464 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipego/runner/config"
+)
+
+var (
+	testBash = Language{
+		Name: langBash,
+	}
+)
+
+func initTask() *task {
+	t := task{
+		cfg: DefaultConfig(),
+		log: Log{},
+	}
+
+	t.cfg.Config = config.Config{}
+	t.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "task",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	return &t
+}
+
+func initFake(name string, f *Fake) Language {
+	Register(name, func(_ context.Context, _ *Config) Executor {
+		return f
+	})
+
+	return Language{Name: name}
+}
+
+func drainLog(log Log) (lines []*Line) {
+	for line := range log.Line.Out {
+		if line.Message == tagEOF {
+			break
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func TestLookup(t *testing.T) {
+	ctx := context.Background()
+
+	e, err := lookup(ctx, DefaultConfig(), langBash)
+	assert.Equal(t, nil, err)
+	assert.IsType(t, &bash{}, e)
+
+	e, err = lookup(ctx, DefaultConfig(), "groovy")
+	assert.Equal(t, nil, err)
+	assert.IsType(t, &docker{}, e)
+
+	f := &Fake{}
+	_ = initFake("fake-lookup", f)
+
+	e, err = lookup(ctx, DefaultConfig(), "fake-lookup")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, f, e)
+}
+
+func TestRunFake(t *testing.T) {
+	f := &Fake{
+		Stdout: "out1\nout2\n",
+		Stderr: "err1\n",
+		Status: Status{ExitCode: 3, Reason: ReasonExited},
+	}
+
+	lang := initFake("fake-run", f)
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, lang, Limit{Resources: Resources{Pids: 10}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, lang, f.Lang)
+
+	err = _t.Run(ctx, "", "/path/to/workspace", []string{"ENV1=task1"}, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "/path/to/workspace", f.Spec.Dir)
+	assert.Equal(t, []string{"ENV1=task1"}, f.Spec.Env)
+	assert.Equal(t, []string{"cmd"}, f.Spec.Cmd)
+	assert.Equal(t, int64(10), f.Spec.Resources.Pids)
+
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 3, len(lines))
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, int64(3), status.ExitCode)
+	assert.Equal(t, ReasonExited, status.Reason)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, f.Cleaned)
+}
+
+func TestRunFakeSecret(t *testing.T) {
+	f := &Fake{
+		Stdout: "token=0123456789abcdef\n",
+		Stderr: "MDEyMzQ1Njc4OWFiY2RlZg==\n",
+		Output: "token=0123456789abcdef\n",
+	}
+
+	lang := initFake("fake-secret", f)
+
+	_t := initTask()
+	ctx := context.Background()
+
+	// Secret is split in width without mask
+	err := _t.Init(ctx, 13, lang, Limit{})
+	assert.Equal(t, nil, err)
+
+	_t.Secret(ctx, []string{"0123456789abcdef"})
+
+	err = _t.Run(ctx, "", t.TempDir(), nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	buf := map[string]string{}
+	for _, item := range drainLog(_t.Tail(ctx)) {
+		buf[item.Stream] += strings.TrimSuffix(item.Message, tagBOL)
+	}
+
+	assert.Equal(t, "token=***\n", buf[StreamStdout])
+	assert.Equal(t, "***\n", buf[StreamStderr])
+
+	_ = _t.Wait(ctx)
+
+	outputs, err := _t.Output(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[string]string{"token": "***"}, outputs)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunFakeAnsi(t *testing.T) {
+	f := &Fake{
+		Stdout: "\x1b[31mred\x1b[0m\n",
+	}
+
+	lang := initFake("fake-ansi", f)
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, lang, Limit{})
+	assert.Equal(t, nil, err)
+
+	err = _t.Ansi(ctx, "invalid")
+	assert.NotEqual(t, nil, err)
+
+	err = _t.Ansi(ctx, AnsiStrip)
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 1, len(lines))
+	assert.Equal(t, "red\n", lines[0].Message)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunFakeOutput(t *testing.T) {
+	f := &Fake{
+		Output: "key1=val1\nkey2<<EOT\nline1\nline2\nEOT\n",
+	}
+
+	lang := initFake("fake-output", f)
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, lang, Limit{})
+	assert.Equal(t, nil, err)
+
+	dir := t.TempDir()
+
+	err = _t.Run(ctx, "", dir, nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, filepath.Join(dir, outputName), f.Spec.Output)
+
+	_ = drainLog(_t.Tail(ctx))
+	_ = _t.Wait(ctx)
+
+	outputs, err := _t.Output(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[string]string{"key1": "val1", "key2": "line1\nline2"}, outputs)
+
+	err = _t.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestRunFakeProgress(t *testing.T) {
+	f := &Fake{
+		Progress: []Progress{
+			{ID: "layer1", Status: "Downloading", Current: 1, Total: 2},
+			{ID: "layer1", Status: "Download complete"},
+		},
+	}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	progress := _t.Progress(ctx)
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-progress", f), Limit{})
+	assert.Equal(t, nil, err)
+
+	var items []Progress
+
+	for item := range progress {
+		items = append(items, item)
+	}
+
+	assert.Equal(t, f.Progress, items)
+}
+
+func TestRunFakeProgressError(t *testing.T) {
+	_t := initTask()
+	ctx := context.Background()
+
+	progress := _t.Progress(ctx)
+
+	err := _t.Init(ctx, lineWidth, Language{Name: "fake-invalid"}, Limit{})
+	assert.NotEqual(t, nil, err)
+
+	_, ok := <-progress
+	assert.Equal(t, false, ok)
+}
+
+func TestRunFakeError(t *testing.T) {
+	ctx := context.Background()
+
+	_t := initTask()
+	err := _t.Init(ctx, lineWidth, initFake("fake-prepare", &Fake{PrepareErr: errors.New("prepare")}), Limit{})
+	assert.NotEqual(t, nil, err)
+
+	_t = initTask()
+	err = _t.Init(ctx, lineWidth, initFake("fake-start", &Fake{StartErr: errors.New("start")}), Limit{})
+	assert.Equal(t, nil, err)
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.NotEqual(t, nil, err)
+
+	_t = initTask()
+	err = _t.Init(ctx, lineWidth, initFake("fake-cancelled", &Fake{}), Limit{})
+	assert.Equal(t, nil, err)
+	err = _t.Cancel(ctx, time.Second)
+	assert.Equal(t, nil, err)
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, ErrCancelled, err)
+}
+
+func TestRunFakeCancel(t *testing.T) {
+	f := &Fake{
+		Delay: time.Hour,
+	}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-cancel", f), Limit{})
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	err = _t.Cancel(ctx, time.Second)
+	assert.Equal(t, nil, err)
+
+	_ = drainLog(_t.Tail(ctx))
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, true, f.Killed)
+	assert.Equal(t, int64(137), status.ExitCode)
+	assert.Equal(t, ReasonCancelled, status.Reason)
+}
+
+func TestRunFakeCancelInit(t *testing.T) {
+	_t := initTask()
+	ctx := context.Background()
+
+	done := make(chan error)
+
+	go func() {
+		done <- _t.Init(ctx, lineWidth, initFake("fake-cancel-init", &Fake{Hold: true}), Limit{})
+	}()
+
+	// Cancel races with Init while image is pulled, and the pull is aborted
+	_ = _t.Cancel(ctx, time.Second)
+
+	assert.Equal(t, ErrCancelled, <-done)
+
+	_t = initTask()
+
+	err := _t.Cancel(ctx, time.Second)
+	assert.Equal(t, nil, err)
+
+	err = _t.Init(ctx, lineWidth, initFake("fake-cancel-before", &Fake{}), Limit{})
+	assert.Equal(t, ErrCancelled, err)
+}
+
+func TestRunFakeTimeout(t *testing.T) {
+	f := &Fake{
+		Delay: time.Hour,
+	}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-timeout", f), Limit{Timeout: 100 * time.Millisecond})
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	_ = drainLog(_t.Tail(ctx))
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, true, f.Killed)
+	assert.Equal(t, ReasonTimeout, status.Reason)
+}
+
+func TestGrace(t *testing.T) {
+	_t := initTask()
+	assert.Equal(t, Grace, _t.grace())
+
+	_t.cfg.Config.Spec.Task.Grace = 5 * time.Second
+	assert.Equal(t, 5*time.Second, _t.grace())
+}
+
+func TestRunFakeDeadline(t *testing.T) {
+	f := &Fake{}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-deadline", f), Limit{Deadline: time.Now().Add(-time.Second)})
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 0, len(lines))
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, ReasonTimeout, status.Reason)
+	assert.Equal(t, Spec{}, f.Spec)
+}
+
+func TestRunFakeTerminal(t *testing.T) {
+	f := &Fake{
+		Status: Status{Reason: ReasonExited},
+		Echo:   true,
+	}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-terminal", f), Limit{})
+	assert.Equal(t, nil, err)
+
+	err = _t.Terminal(ctx, 0, 0)
+	assert.Equal(t, nil, err)
+
+	err = _t.Resize(ctx, 30, 100)
+	assert.Equal(t, nil, err)
+
+	stdin, err := _t.Stdin(ctx)
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, f.Spec.Tty)
+	assert.Equal(t, uint16(30), f.Spec.Rows)
+	assert.Equal(t, uint16(100), f.Spec.Cols)
+
+	err = _t.Resize(ctx, 40, 120)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, [][2]uint16{{40, 120}}, f.Sizes)
+
+	_, _ = stdin.Write([]byte("hello\n"))
+	_ = stdin.Close()
+
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 1, len(lines))
+	assert.Equal(t, "hello\n", lines[0].Message)
+
+	status := _t.Wait(ctx)
+	assert.Equal(t, ReasonExited, status.Reason)
+}
+
+func TestRunFakePartial(t *testing.T) {
+	f := &Fake{
+		Stdout: "Continue? [y/N] \ntail",
+	}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-partial", f), Limit{})
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	// Unterminated line is sent at exit
+	lines := drainLog(_t.Tail(ctx))
+	assert.Equal(t, 2, len(lines))
+	assert.Equal(t, "Continue? [y/N] \n", lines[0].Message)
+	assert.Equal(t, "tail", lines[1].Message)
+	assert.Equal(t, int64(2), lines[1].Pos)
+}
+
+func TestRunFakePrompt(t *testing.T) {
+	f := &Fake{
+		Stdout: "Continue? [y/N] ",
+		Echo:   true,
+	}
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err := _t.Init(ctx, lineWidth, initFake("fake-prompt", f), Limit{})
+	assert.Equal(t, nil, err)
+
+	stdin, err := _t.Stdin(ctx)
+	assert.Equal(t, nil, err)
+
+	err = _t.Run(ctx, "", "", nil, []string{"cmd"}, "")
+	assert.Equal(t, nil, err)
+
+	// Prompt is sent before the answer is written into stdin
+	log := _t.Tail(ctx)
+
+	line := <-log.Line.Out
+	assert.Equal(t, "Continue? [y/N] ", line.Message)
+	assert.Equal(t, int64(1), line.Pos)
+
+	_, _ = stdin.Write([]byte("y\n"))
+	_ = stdin.Close()
+
+	lines := drainLog(log)
+	assert.Equal(t, 1, len(lines))
+	assert.Equal(t, "y\n", lines[0].Message)
+	assert.Equal(t, int64(1), lines[0].Pos)
+}
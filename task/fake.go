@@ -0,0 +1,163 @@
+package task
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Fake is the in-memory executor for tests, which writes Stdout and Stderr and then exits in Status after Delay.
+// Stdin is written into stdout if Echo is enabled, and it exits as stdin is closed. Output is written into
+// the output file of task. Prepare blocks until cancelled if Hold is enabled, as in a long image pull.
+type Fake struct {
+	Stdout     string
+	Stderr     string
+	Output     string
+	Status     Status
+	Delay      time.Duration
+	Echo       bool
+	Progress   []Progress
+	Hold       bool
+	PrepareErr error
+	StartErr   error
+
+	Lang    Language
+	Spec    Spec
+	Sizes   [][2]uint16
+	Killed  bool
+	Cleaned bool
+
+	mu     sync.Mutex
+	stdout io.Reader
+	stderr io.Reader
+	echo   *io.PipeWriter
+	killed chan struct{}
+}
+
+func (f *Fake) Prepare(ctx context.Context, lang Language, progress func(Progress)) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Lang = lang
+	f.killed = make(chan struct{})
+
+	for _, item := range f.Progress {
+		progress(item)
+	}
+
+	if f.Hold {
+		<-ctx.Done()
+		return errors.Wrap(ctx.Err(), "failed to pull")
+	}
+
+	return f.PrepareErr
+}
+
+func (f *Fake) Start(_ context.Context, spec Spec) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.StartErr != nil {
+		return f.StartErr
+	}
+
+	if f.killed == nil {
+		f.killed = make(chan struct{})
+	}
+
+	f.Spec = spec
+	f.stdout = strings.NewReader(f.Stdout)
+
+	if f.Output != "" && spec.Output != "" {
+		if err := os.WriteFile(spec.Output, []byte(f.Output), outputPerm); err != nil {
+			return err
+		}
+	}
+	f.stderr = strings.NewReader(f.Stderr)
+
+	if f.Echo && spec.Stdin != nil {
+		r, w := io.Pipe()
+		f.echo = w
+		go func() {
+			_, err := io.Copy(w, spec.Stdin)
+			_ = w.CloseWithError(err)
+		}()
+		f.stdout = io.MultiReader(f.stdout, r)
+	}
+
+	return nil
+}
+
+func (f *Fake) Stream(_ context.Context) (stdout, stderr io.Reader) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.stdout, f.stderr
+}
+
+func (f *Fake) Wait(ctx context.Context) Status {
+	f.mu.Lock()
+	killed := f.killed
+	f.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return Status{}
+	case <-killed:
+		return Status{
+			ExitCode: signalBase + int64(syscall.SIGKILL),
+			Signal:   syscall.SIGKILL.String(),
+			Reason:   ReasonSignaled,
+		}
+	case <-time.After(f.Delay):
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.Status
+}
+
+func (f *Fake) Kill(_ context.Context, _ time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.killed == nil {
+		return errors.New("fake not prepared")
+	}
+
+	if !f.Killed {
+		f.Killed = true
+		close(f.killed)
+	}
+
+	if f.echo != nil {
+		_ = f.echo.CloseWithError(errors.New("fake killed"))
+	}
+
+	return nil
+}
+
+func (f *Fake) Resize(_ context.Context, rows, cols uint16) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Sizes = append(f.Sizes, [2]uint16{rows, cols})
+
+	return nil
+}
+
+func (f *Fake) Cleanup(_ context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.Cleaned = true
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package task
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	outputName  = ".pipego-output"
+	outputPerm  = 0644
+	outputSize  = 1048576
+	outputCount = 1000
+	outputDelim = "<<"
+)
+
+var (
+	outputKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]{0,127}$`)
+)
+
+// readOutput parses the outputs written by task in file, and no output is returned if file is not found.
+// The file is controlled by task, so it must be a regular file instead of symlink to the files of host.
+func readOutput(name string) (map[string]string, error) {
+	info, err := os.Lstat(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to stat output")
+	}
+
+	if !info.Mode().IsRegular() {
+		return nil, errors.New("invalid output file")
+	}
+
+	f, err := os.OpenFile(name, outputFlag, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open output")
+	}
+
+	defer func(f *os.File) {
+		_ = f.Close()
+	}(f)
+
+	if info, err := f.Stat(); err != nil || !info.Mode().IsRegular() {
+		return nil, errors.New("invalid output file")
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(f, outputSize+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read output")
+	}
+
+	if len(buf) > outputSize {
+		return nil, errors.New("output exceeded")
+	}
+
+	return parseOutput(buf)
+}
+
+// parseOutput parses data in JSON object, or in lines of KEY=VALUE. The multiline value is written in
+// KEY<<DELIMITER, and ended with the line of DELIMITER. Blank lines and comments in # are skipped.
+func parseOutput(data []byte) (map[string]string, error) {
+	var err error
+
+	data = bytes.TrimSpace(data)
+	outputs := map[string]string{}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if data[0] == '{' {
+		err = parseJSON(data, outputs)
+	} else {
+		err = parseLines(data, outputs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(outputs) > outputCount {
+		return nil, errors.New("too many outputs")
+	}
+
+	for key, val := range outputs {
+		if !outputKey.MatchString(key) {
+			return nil, errors.New("invalid output key: " + key)
+		}
+		if !utf8.ValidString(val) {
+			return nil, errors.New("invalid output value: " + key)
+		}
+	}
+
+	return outputs, nil
+}
+
+// parseJSON keeps the string values, and the other values are kept in JSON text
+func parseJSON(data []byte, outputs map[string]string) error {
+	var buf map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &buf); err != nil {
+		return errors.Wrap(err, "failed to unmarshal output")
+	}
+
+	for key, val := range buf {
+		var s string
+		if err := json.Unmarshal(val, &s); err == nil {
+			outputs[key] = s
+			continue
+		}
+		var b bytes.Buffer
+		if err := json.Compact(&b, val); err != nil {
+			return errors.Wrap(err, "failed to compact output")
+		}
+		outputs[key] = b.String()
+	}
+
+	return nil
+}
+
+func parseLines(data []byte, outputs map[string]string) error {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), outputSize)
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+
+		if key, delim, ok := strings.Cut(line, outputDelim); ok && !strings.Contains(key, "=") {
+			var buf []string
+			found := false
+			for scanner.Scan() {
+				item := strings.TrimSuffix(scanner.Text(), "\r")
+				if item == delim {
+					found = true
+					break
+				}
+				buf = append(buf, item)
+			}
+			if delim == "" || !found {
+				return errors.New("invalid output delimiter: " + key)
+			}
+			outputs[key] = strings.Join(buf, "\n")
+			continue
+		}
+
+		key, val, ok := strings.Cut(line, "=")
+		if !ok {
+			return errors.New("invalid output line")
+		}
+
+		outputs[key] = val
+	}
+
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "failed to scan output")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package task
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		outputs map[string]string
+		err     bool
+	}{
+		{name: "empty", data: " \n", outputs: nil},
+		{name: "lines", data: "key1=val1\n\n# comment\nkey2=a=b\r\nkey3=\n", outputs: map[string]string{
+			"key1": "val1", "key2": "a=b", "key3": "",
+		}},
+		{name: "override", data: "key=val1\nkey=val2\n", outputs: map[string]string{"key": "val2"}},
+		{name: "multiline", data: "key<<EOT\nline1\n\nline2\nEOT\nnext=val\n", outputs: map[string]string{
+			"key": "line1\n\nline2", "next": "val",
+		}},
+		{name: "value with delimiter", data: "key=a<<b\n", outputs: map[string]string{"key": "a<<b"}},
+		{name: "json", data: `{"key1": "val1", "key2": 2, "key3": {"a": [1, true]}, "key4": null}`, outputs: map[string]string{
+			"key1": "val1", "key2": "2", "key3": `{"a":[1,true]}`, "key4": "",
+		}},
+		{name: "invalid line", data: "key\n", err: true},
+		{name: "invalid key", data: "1key=val\n", err: true},
+		{name: "invalid key in json", data: `{"a b": "val"}`, err: true},
+		{name: "invalid json", data: `{"key": }`, err: true},
+		{name: "unterminated delimiter", data: "key<<EOT\nline1\n", err: true},
+		{name: "empty delimiter", data: "key<<\nline1\n", err: true},
+		{name: "invalid utf8", data: "key=\xff\n", err: true},
+		{name: "too many", data: func() string {
+			var b strings.Builder
+			for i := 0; i <= outputCount; i++ {
+				b.WriteString("key" + strconv.Itoa(i) + "=val\n")
+			}
+			return b.String()
+		}(), err: true},
+	}
+
+	for _, item := range tests {
+		t.Run(item.name, func(t *testing.T) {
+			outputs, err := parseOutput([]byte(item.data))
+			assert.Equal(t, item.err, err != nil)
+			assert.Equal(t, item.outputs, outputs)
+		})
+	}
+}
+
+func TestReadOutput(t *testing.T) {
+	dir := t.TempDir()
+
+	outputs, err := readOutput(filepath.Join(dir, outputName))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(outputs))
+
+	_ = os.WriteFile(filepath.Join(dir, outputName), []byte("key=val\n"), outputPerm)
+
+	outputs, err = readOutput(filepath.Join(dir, outputName))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, map[string]string{"key": "val"}, outputs)
+
+	_ = os.WriteFile(filepath.Join(dir, outputName), []byte("key="+strings.Repeat("a", outputSize)), outputPerm)
+
+	_, err = readOutput(filepath.Join(dir, outputName))
+	assert.NotEqual(t, nil, err)
+
+	host := filepath.Join(t.TempDir(), ".env")
+	_ = os.WriteFile(host, []byte("secret=val\n"), outputPerm)
+	_ = os.Remove(filepath.Join(dir, outputName))
+
+	if err := os.Symlink(host, filepath.Join(dir, outputName)); err != nil {
+		t.Skip("symlink not supported")
+	}
+
+	outputs, err = readOutput(filepath.Join(dir, outputName))
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, 0, len(outputs))
+
+	_ = os.Remove(filepath.Join(dir, outputName))
+	_ = os.Mkdir(filepath.Join(dir, outputName), 0755)
+
+	_, err = readOutput(filepath.Join(dir, outputName))
+	assert.NotEqual(t, nil, err)
+}
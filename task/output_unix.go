@@ -0,0 +1,11 @@
+//go:build linux || darwin
+
+package task
+
+import (
+	"os"
+	"syscall"
+)
+
+// outputFlag opens output without following symlink in the last element
+const outputFlag = os.O_RDONLY | syscall.O_NOFOLLOW
@@ -0,0 +1,10 @@
+//go:build windows
+
+package task
+
+import (
+	"os"
+)
+
+// outputFlag opens output in read only, and symlink is rejected in Lstat since O_NOFOLLOW is not supported
+const outputFlag = os.O_RDONLY
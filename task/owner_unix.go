@@ -0,0 +1,19 @@
+//go:build linux || darwin
+
+package task
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// chownAll changes the owner of path and the files in it without following symlinks
+func chownAll(path string, uid, gid int) error {
+	return filepath.WalkDir(path, func(name string, _ fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Lchown(name, uid, gid)
+	})
+}
@@ -0,0 +1,8 @@
+//go:build windows
+
+package task
+
+// chownAll is skipped since the owner in uid and gid is not applied to mounts on Windows
+func chownAll(_ string, _, _ int) error {
+	return nil
+}
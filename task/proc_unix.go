@@ -0,0 +1,52 @@
+//go:build linux || darwin
+
+package task
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/creack/pty"
+	"github.com/pkg/errors"
+)
+
+func (b *bash) setProcAttr(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	c.Cancel = func() error {
+		return b.signalProc(c, syscall.SIGKILL)
+	}
+}
+
+func (b *bash) signalProc(c *exec.Cmd, sig syscall.Signal) error {
+	if c.Process == nil {
+		return errors.New("invalid process")
+	}
+
+	return syscall.Kill(-c.Process.Pid, sig)
+}
+
+// openTerminal sets the tty of terminal as stdio of process, which is the controlling terminal in a new session
+func (b *bash) openTerminal(c *exec.Cmd, rows, cols uint16) (ptmx, tty *os.File, err error) {
+	ptmx, tty, err = pty.Open()
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to open")
+	}
+
+	if err = b.resizeTerminal(ptmx, rows, cols); err != nil {
+		_ = ptmx.Close()
+		_ = tty.Close()
+		return nil, nil, errors.Wrap(err, "failed to resize")
+	}
+
+	c.Stdin = tty
+	c.Stdout = tty
+	c.Stderr = tty
+	c.SysProcAttr = &syscall.SysProcAttr{Setsid: true, Setctty: true}
+
+	return ptmx, tty, nil
+}
+
+func (b *bash) resizeTerminal(ptmx *os.File, rows, cols uint16) error {
+	return pty.Setsize(ptmx, &pty.Winsize{Rows: rows, Cols: cols})
+}
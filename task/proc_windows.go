@@ -0,0 +1,29 @@
+//go:build windows
+
+package task
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+func (b *bash) setProcAttr(_ *exec.Cmd) {}
+
+func (b *bash) signalProc(c *exec.Cmd, _ syscall.Signal) error {
+	if c.Process == nil {
+		return errors.New("invalid process")
+	}
+
+	return c.Process.Kill()
+}
+
+func (b *bash) openTerminal(_ *exec.Cmd, _, _ uint16) (ptmx, tty *os.File, err error) {
+	return nil, nil, errors.New("terminal not supported")
+}
+
+func (b *bash) resizeTerminal(_ *os.File, _, _ uint16) error {
+	return errors.New("terminal not supported")
+}
@@ -0,0 +1,190 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/registry"
+	"github.com/pkg/errors"
+
+	"github.com/pipego/runner/config"
+)
+
+const (
+	registryHub      = "docker.io"
+	registryIndex    = "index.docker.io"
+	registryHubURL   = "https://index.docker.io/v1/"
+	registryHelper   = "docker-credential-"
+	registryToken    = "<token>"
+	registryAuthSize = 2
+)
+
+// dockerConfig is the credentials of registries in config.json of docker
+type dockerConfig struct {
+	Auths       map[string]dockerAuth `json:"auths"`
+	CredsStore  string                `json:"credsStore"`
+	CredHelpers map[string]string     `json:"credHelpers"`
+}
+
+type dockerAuth struct {
+	Auth          string `json:"auth"`
+	Username      string `json:"username"`
+	Password      string `json:"password"`
+	IdentityToken string `json:"identitytoken"`
+}
+
+// helperCredential is the output of credential helper
+type helperCredential struct {
+	Username string `json:"Username"`
+	Secret   string `json:"Secret"`
+}
+
+// registryAuth returns the credential of image, which is looked up in order of name of credential,
+// host of credential in runner config and config.json of docker. Empty credential is returned if not found.
+func registryAuth(ctx context.Context, cfg config.Registry, name, image string) (registry.AuthConfig, error) {
+	host, err := registryHost(image)
+	if err != nil {
+		return registry.AuthConfig{}, errors.Wrap(err, "failed to parse image")
+	}
+
+	if name != "" {
+		for _, item := range cfg.Credentials {
+			if item.Name != name {
+				continue
+			}
+			if item.Host != "" && normalizeHost(item.Host) != host {
+				return registry.AuthConfig{}, errors.New("credential not matched")
+			}
+			return registry.AuthConfig{Username: item.User, Password: item.Pass, ServerAddress: host}, nil
+		}
+		return registry.AuthConfig{}, errors.New("credential not found")
+	}
+
+	for _, item := range cfg.Credentials {
+		if item.Host != "" && normalizeHost(item.Host) == host {
+			return registry.AuthConfig{Username: item.User, Password: item.Pass, ServerAddress: host}, nil
+		}
+	}
+
+	if cfg.Config == "" {
+		return registry.AuthConfig{}, nil
+	}
+
+	return configAuth(ctx, cfg.Config, host)
+}
+
+// configAuth returns the credential of host in config.json of docker, and the credential helper
+// of host or credential store is preferred to auths
+func configAuth(ctx context.Context, name, host string) (registry.AuthConfig, error) {
+	buf, err := os.ReadFile(name)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return registry.AuthConfig{}, nil
+		}
+		return registry.AuthConfig{}, errors.Wrap(err, "failed to read config")
+	}
+
+	var c dockerConfig
+
+	if err := json.Unmarshal(buf, &c); err != nil {
+		return registry.AuthConfig{}, errors.Wrap(err, "failed to unmarshal config")
+	}
+
+	helper := c.CredsStore
+
+	for key, val := range c.CredHelpers {
+		if normalizeHost(key) == host {
+			helper = val
+			break
+		}
+	}
+
+	if helper != "" {
+		return helperAuth(ctx, helper, host)
+	}
+
+	for key, val := range c.Auths {
+		if normalizeHost(key) != host {
+			continue
+		}
+		auth := registry.AuthConfig{
+			Username:      val.Username,
+			Password:      val.Password,
+			IdentityToken: val.IdentityToken,
+			ServerAddress: host,
+		}
+		if val.Auth != "" {
+			buf, err := base64.StdEncoding.DecodeString(val.Auth)
+			if err != nil {
+				return registry.AuthConfig{}, errors.New("invalid auth")
+			}
+			pair := strings.SplitN(string(buf), ":", registryAuthSize)
+			if len(pair) != registryAuthSize {
+				return registry.AuthConfig{}, errors.New("invalid auth")
+			}
+			auth.Username, auth.Password = pair[0], pair[1]
+		}
+		return auth, nil
+	}
+
+	return registry.AuthConfig{}, nil
+}
+
+// helperAuth runs credential helper to get the credential of host, and the output of helper
+// is never returned in error since it may contain the secret
+func helperAuth(ctx context.Context, helper, host string) (registry.AuthConfig, error) {
+	server := host
+	if host == registryHub {
+		server = registryHubURL
+	}
+
+	var out bytes.Buffer
+
+	c := exec.CommandContext(ctx, registryHelper+helper, "get")
+	c.Stdin = strings.NewReader(server)
+	c.Stdout = &out
+
+	if err := c.Run(); err != nil {
+		return registry.AuthConfig{}, errors.Wrap(err, "failed to run helper")
+	}
+
+	var cred helperCredential
+
+	if err := json.Unmarshal(out.Bytes(), &cred); err != nil {
+		return registry.AuthConfig{}, errors.New("invalid helper output")
+	}
+
+	if cred.Username == registryToken {
+		return registry.AuthConfig{IdentityToken: cred.Secret, ServerAddress: host}, nil
+	}
+
+	return registry.AuthConfig{Username: cred.Username, Password: cred.Secret, ServerAddress: host}, nil
+}
+
+// registryHost returns the registry host of image, e.g. docker.io for craftslab/groovy:latest
+func registryHost(image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", err
+	}
+
+	return normalizeHost(reference.Domain(named)), nil
+}
+
+// normalizeHost trims scheme and path of host in config, and index of docker hub is taken as docker.io
+func normalizeHost(host string) string {
+	host = strings.TrimPrefix(strings.TrimPrefix(host, "https://"), "http://")
+	host, _, _ = strings.Cut(host, "/")
+
+	if host == registryIndex {
+		return registryHub
+	}
+
+	return host
+}
@@ -0,0 +1,147 @@
+package task
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/docker/docker/api/types/registry"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipego/runner/config"
+)
+
+func TestRegistryHost(t *testing.T) {
+	host, err := registryHost("craftslab/groovy:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "docker.io", host)
+
+	host, err = registryHost("ghcr.io/pipego/runner:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "ghcr.io", host)
+
+	host, err = registryHost("localhost:5000/groovy")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "localhost:5000", host)
+
+	_, err = registryHost("INVALID")
+	assert.NotEqual(t, nil, err)
+
+	assert.Equal(t, "docker.io", normalizeHost("https://index.docker.io/v1/"))
+	assert.Equal(t, "ghcr.io", normalizeHost("http://ghcr.io"))
+}
+
+func TestRegistryAuth(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := config.Registry{
+		Credentials: []config.Credential{
+			{Name: "hub", Host: "docker.io", User: "user1", Pass: "pass1"},
+			{Name: "ghcr", Host: "https://ghcr.io", User: "user2", Pass: "pass2"},
+			{Name: "any", User: "user3", Pass: "pass3"},
+		},
+	}
+
+	auth, err := registryAuth(ctx, cfg, "hub", "craftslab/groovy:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "user1", auth.Username)
+	assert.Equal(t, "pass1", auth.Password)
+
+	auth, err = registryAuth(ctx, cfg, "any", "ghcr.io/pipego/runner:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "user3", auth.Username)
+
+	_, err = registryAuth(ctx, cfg, "hub", "ghcr.io/pipego/runner:latest")
+	assert.NotEqual(t, nil, err)
+
+	_, err = registryAuth(ctx, cfg, "invalid", "craftslab/groovy:latest")
+	assert.NotEqual(t, nil, err)
+
+	auth, err = registryAuth(ctx, cfg, "", "ghcr.io/pipego/runner:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "user2", auth.Username)
+
+	auth, err = registryAuth(ctx, cfg, "", "quay.io/pipego/runner:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, registry.AuthConfig{}, auth)
+}
+
+func TestConfigAuth(t *testing.T) {
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "config.json")
+
+	// auth of user:pass
+	err := os.WriteFile(name, []byte(`{
+  "auths": {
+    "https://index.docker.io/v1/": {"auth": "dXNlcjpwYXNz"},
+    "ghcr.io": {"identitytoken": "token"},
+    "quay.io": {"auth": "invalid"}
+  }
+}`), 0600)
+	assert.Equal(t, nil, err)
+
+	cfg := config.Registry{Config: name}
+
+	auth, err := registryAuth(ctx, cfg, "", "craftslab/groovy:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "user", auth.Username)
+	assert.Equal(t, "pass", auth.Password)
+
+	auth, err = registryAuth(ctx, cfg, "", "ghcr.io/pipego/runner:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "token", auth.IdentityToken)
+
+	_, err = registryAuth(ctx, cfg, "", "quay.io/pipego/runner:latest")
+	assert.NotEqual(t, nil, err)
+
+	auth, err = registryAuth(ctx, config.Registry{Config: filepath.Join(t.TempDir(), "invalid.json")}, "",
+		"craftslab/groovy:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, registry.AuthConfig{}, auth)
+}
+
+func TestHelperAuth(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper not supported")
+	}
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(dir, "docker-credential-fake"), []byte(`#!/bin/sh
+read server
+if [ "$server" = "https://index.docker.io/v1/" ]; then
+  echo '{"ServerURL":"'$server'","Username":"user","Secret":"pass"}'
+else
+  echo '{"ServerURL":"'$server'","Username":"<token>","Secret":"token"}'
+fi
+`), 0700)
+	assert.Equal(t, nil, err)
+
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	name := filepath.Join(dir, "config.json")
+
+	err = os.WriteFile(name, []byte(`{
+  "auths": {"ghcr.io": {"auth": "dXNlcjpwYXNz"}},
+  "credHelpers": {"ghcr.io": "fake", "quay.io": "invalid"},
+  "credsStore": "fake"
+}`), 0600)
+	assert.Equal(t, nil, err)
+
+	cfg := config.Registry{Config: name}
+
+	auth, err := registryAuth(ctx, cfg, "", "craftslab/groovy:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "user", auth.Username)
+	assert.Equal(t, "pass", auth.Password)
+
+	auth, err = registryAuth(ctx, cfg, "", "ghcr.io/pipego/runner:latest")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "token", auth.IdentityToken)
+
+	_, err = registryAuth(ctx, cfg, "", "quay.io/pipego/runner:latest")
+	assert.NotEqual(t, nil, err)
+}
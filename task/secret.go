@@ -0,0 +1,67 @@
+package task
+
+import (
+	"encoding/base64"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	secretMask = "***"
+)
+
+// newMasker returns the replacer of secrets in mask, and the secrets are also matched in their lines and
+// encoded forms in base64 and URL. No replacer is returned if there is no secret.
+func newMasker(secrets []string) *strings.Replacer {
+	var buf []string
+
+	found := map[string]bool{}
+
+	helper := func(s string) {
+		forms := []string{
+			s,
+			base64.StdEncoding.EncodeToString([]byte(s)),
+			base64.RawStdEncoding.EncodeToString([]byte(s)),
+			base64.URLEncoding.EncodeToString([]byte(s)),
+			base64.RawURLEncoding.EncodeToString([]byte(s)),
+			url.QueryEscape(s),
+			url.PathEscape(s),
+		}
+		for _, item := range forms {
+			if item != "" && !found[item] {
+				found[item] = true
+				buf = append(buf, item)
+			}
+		}
+	}
+
+	for _, item := range secrets {
+		helper(item)
+		// Output is matched in lines, and each line of secret is matched separately
+		if strings.ContainsAny(item, "\r\n") {
+			for _, line := range strings.Split(item, "\n") {
+				helper(strings.TrimSpace(line))
+			}
+		}
+	}
+
+	if len(buf) == 0 {
+		return nil
+	}
+
+	// Longer one is matched first since replacer matches in order of arguments
+	sort.Slice(buf, func(i, j int) bool {
+		if len(buf[i]) != len(buf[j]) {
+			return len(buf[i]) > len(buf[j])
+		}
+		return buf[i] < buf[j]
+	})
+
+	args := make([]string, 0, len(buf)*2)
+	for _, item := range buf {
+		args = append(args, item, secretMask)
+	}
+
+	return strings.NewReplacer(args...)
+}
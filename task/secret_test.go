@@ -0,0 +1,34 @@
+package task
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMasker(t *testing.T) {
+	m := newMasker(nil)
+	assert.Equal(t, (*strings.Replacer)(nil), m)
+
+	m = newMasker([]string{""})
+	assert.Equal(t, (*strings.Replacer)(nil), m)
+
+	m = newMasker([]string{"p@ss w/rd", "line1\nline2\n"})
+
+	tests := map[string]string{
+		"token: p@ss w/rd\n":          "token: ***\n",
+		"base64: cEBzcyB3L3Jk\n":      "base64: ***\n",
+		"query: p%40ss+w%2Frd\n":      "query: ***\n",
+		"path: p@ss%20w%2Frd\n":       "path: ***\n",
+		"key: line1\n":                "key: ***\n",
+		"keys: line1\nline2\n":        "keys: ***",
+		"base64: bGluZTEKbGluZTIK\n":  "base64: ***\n",
+		"nothing to mask: p@ss\n":     "nothing to mask: p@ss\n",
+		"twice: p@ss w/rdp@ss w/rd\n": "twice: ******\n",
+	}
+
+	for data, expected := range tests {
+		assert.Equal(t, expected, m.Replace(data), data)
+	}
+}
@@ -0,0 +1,201 @@
+package task
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/width"
+)
+
+const (
+	ansiEsc = 0x1b
+	ansiBel = 0x07
+	ansiCSI = '['
+	ansiOSC = ']'
+	ansiST  = '\\'
+	ansiEL  = 'K'
+
+	lineCRLF = "\r\n"
+)
+
+// cell is the visible rune in line, and seq is the escape sequences written before it
+type cell struct {
+	seq   string
+	r     rune
+	width int
+}
+
+// splitLine splits line into the messages in display width, and BOL is appended to each message except the last.
+// The escape sequences are zero-width and never split, and they are removed if strip is enabled. The overwrites
+// in carriage return are collapsed into the final visible state of line.
+func splitLine(line string, w int, strip bool) []string {
+	var buf []string
+	var b strings.Builder
+
+	cells, tail, sep := parseLine(line)
+
+	if w <= 0 {
+		w = 1
+	}
+
+	n := 0
+
+	for _, item := range cells {
+		if n > 0 && n+item.width > w {
+			buf = append(buf, b.String()+tagBOL)
+			b.Reset()
+			n = 0
+		}
+		if !strip {
+			b.WriteString(item.seq)
+		}
+		b.WriteRune(item.r)
+		n += item.width
+	}
+
+	if !strip {
+		b.WriteString(tail)
+	}
+
+	b.WriteString(sep)
+
+	return append(buf, b.String())
+}
+
+// parseLine returns the visible cells of line in terminal, and the escape sequences after the last cell and the line
+// separator (LF or CRLF). Carriage return moves back to the beginning of line, and the cells written later overwrite
+// the former.
+func parseLine(line string) (cells []cell, tail, sep string) {
+	var seq strings.Builder
+
+	if strings.HasSuffix(line, lineCRLF) {
+		line, sep = line[:len(line)-len(lineCRLF)], lineCRLF
+	} else if strings.HasSuffix(line, string(lineSep)) {
+		line, sep = line[:len(line)-1], string(lineSep)
+	}
+
+	pos := 0
+
+	for i := 0; i < len(line); {
+		if line[i] == ansiEsc {
+			n := ansiLen(line[i:])
+			if mode, ok := ansiErase(line[i : i+n]); ok {
+				// Erase in line is applied in cells, and it is not kept in output
+				cells = eraseLine(cells, pos, mode)
+			} else {
+				seq.WriteString(line[i : i+n])
+			}
+			i += n
+			continue
+		}
+
+		if line[i] == '\r' {
+			pos = 0
+			i++
+			continue
+		}
+
+		r, n := utf8.DecodeRuneInString(line[i:])
+		i += n
+
+		c := cell{seq: seq.String(), r: r, width: runeWidth(r)}
+		seq.Reset()
+
+		for len(cells) < pos {
+			cells = append(cells, cell{r: ' ', width: 1})
+		}
+
+		if pos < len(cells) {
+			cells[pos] = c
+		} else {
+			cells = append(cells, c)
+		}
+
+		pos++
+	}
+
+	return cells, seq.String(), sep
+}
+
+// ansiLen returns the length of escape sequence in the beginning of data, which is CSI (ESC [ ... final),
+// OSC (ESC ] ... BEL or ESC \) or ESC followed by one byte. The unterminated sequence takes the rest of data.
+func ansiLen(data string) int {
+	if len(data) < 2 {
+		return len(data)
+	}
+
+	switch data[1] {
+	case ansiCSI:
+		for i := 2; i < len(data); i++ {
+			if data[i] >= 0x40 && data[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(data)
+	case ansiOSC:
+		for i := 2; i < len(data); i++ {
+			if data[i] == ansiBel {
+				return i + 1
+			}
+			if data[i] == ansiEsc && i+1 < len(data) && data[i+1] == ansiST {
+				return i + 2
+			}
+		}
+		return len(data)
+	default:
+		_, n := utf8.DecodeRuneInString(data[1:])
+		return 1 + n
+	}
+}
+
+// ansiErase returns the mode of erase in line (ESC [ n K), which is 0 to the end, 1 to the beginning and 2 in all
+func ansiErase(seq string) (string, bool) {
+	if len(seq) < 3 || seq[1] != ansiCSI || seq[len(seq)-1] != ansiEL {
+		return "", false
+	}
+
+	mode := seq[2 : len(seq)-1]
+	if mode == "" {
+		mode = "0"
+	}
+
+	if mode != "0" && mode != "1" && mode != "2" {
+		return "", false
+	}
+
+	return mode, true
+}
+
+// eraseLine erases cells in mode of erase in line, and the erased before pos are blank
+func eraseLine(cells []cell, pos int, mode string) []cell {
+	switch mode {
+	case "0":
+		if pos < len(cells) {
+			cells = cells[:pos]
+		}
+	case "1":
+		for i := 0; i <= pos && i < len(cells); i++ {
+			cells[i] = cell{r: ' ', width: 1}
+		}
+	case "2":
+		cells = cells[:0]
+	}
+
+	return cells
+}
+
+// runeWidth returns the display width of r, which is 2 for wide and fullwidth runes in East Asian
+// and 0 for combining marks and format characters
+func runeWidth(r rune) int {
+	if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+		return 0
+	}
+
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth:
+		return 2
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,54 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitLine(t *testing.T) {
+	red := "\x1b[31m"
+	reset := "\x1b[0m"
+	link := "\x1b]8;;https://pipego.dev\x07"
+
+	tests := []struct {
+		name     string
+		line     string
+		width    int
+		strip    bool
+		expected []string
+	}{
+		{name: "empty", line: "\n", width: 4, expected: []string{"\n"}},
+		{name: "fit", line: "abcd\n", width: 4, expected: []string{"abcd\n"}},
+		{name: "split", line: "abcdefghij\n", width: 4, expected: []string{"abcd" + tagBOL, "efgh" + tagBOL, "ij\n"}},
+		{name: "no separator", line: "abcde", width: 4, expected: []string{"abcd" + tagBOL, "e"}},
+		{name: "crlf", line: "abc\r\n", width: 4, expected: []string{"abc\r\n"}},
+		{name: "wide", line: "中文字\n", width: 4, expected: []string{"中文" + tagBOL, "字\n"}},
+		{name: "wide not split", line: "a中文\n", width: 4, expected: []string{"a中" + tagBOL, "文\n"}},
+		{name: "wide in narrow", line: "中\n", width: 1, expected: []string{"中\n"}},
+		{name: "combining", line: "éé\n", width: 2, expected: []string{"éé\n"}},
+		{name: "ansi zero width", line: red + "abcd" + reset + "\n", width: 4, expected: []string{red + "abcd" + reset + "\n"}},
+		{name: "ansi not cut", line: "ab" + red + "cdef" + reset + "\n", width: 4,
+			expected: []string{"ab" + red + "cd" + tagBOL, "ef" + reset + "\n"}},
+		{name: "ansi strip", line: "ab" + red + "cdef" + reset + "\n", width: 4, strip: true,
+			expected: []string{"abcd" + tagBOL, "ef\n"}},
+		{name: "osc", line: link + "ab" + "\x1b]8;;\x1b\\" + "\n", width: 2, expected: []string{link + "ab" + "\x1b]8;;\x1b\\" + "\n"}},
+		{name: "osc strip", line: link + "ab" + "\x1b]8;;\x1b\\" + "\n", width: 2, strip: true, expected: []string{"ab\n"}},
+		{name: "unterminated", line: "ab\x1b[31", width: 2, strip: true, expected: []string{"ab"}},
+		{name: "progress", line: "10%\r50%\r100%\n", width: 4, expected: []string{"100%\n"}},
+		{name: "overwrite", line: "long text\rshort\n", width: 20, expected: []string{"shorttext\n"}},
+		{name: "erase", line: "long text\x1b[2K\rshort\n", width: 20, expected: []string{"short\n"}},
+		{name: "erase to end", line: "long text\rshort\x1b[K\n", width: 20, expected: []string{"short\n"}},
+		{name: "erase to beginning", line: "long text\rlong\x1b[1Kx\n", width: 20, expected: []string{"    xtext\n"}},
+		{name: "erase in all", line: "long\x1b[2Kx\n", width: 20, expected: []string{"    x\n"}},
+		{name: "progress ansi", line: red + "10%" + reset + "\r" + red + "100%" + reset + "\r\n", width: 4,
+			expected: []string{reset + red + "100%" + reset + "\r\n"}},
+		{name: "invalid width", line: "ab\n", width: 0, expected: []string{"a" + tagBOL, "b\n"}},
+	}
+
+	for _, item := range tests {
+		t.Run(item.name, func(t *testing.T) {
+			assert.Equal(t, item.expected, splitLine(item.line, item.width, item.strip))
+		})
+	}
+}
@@ -2,25 +2,18 @@ package task
 
 import (
 	"bufio"
+	"bytes"
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"io"
+	"math"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 	"unicode/utf8"
 
-	"github.com/docker/docker/api/types/container"
-	"github.com/docker/docker/api/types/filters"
-	"github.com/docker/docker/api/types/image"
-	"github.com/docker/docker/api/types/mount"
-	"github.com/docker/docker/api/types/network"
-	"github.com/docker/docker/api/types/registry"
-	"github.com/docker/docker/client"
 	"github.com/hashicorp/go-hclog"
 	"github.com/pkg/errors"
 	"github.com/smallnest/chanx"
@@ -33,38 +26,131 @@ const (
 	langBash   = "bash"
 	langTarget = "/workspace"
 
+	networkBridge = "bridge"
+	networkHost   = "host"
+	networkNone   = "none"
+
+	cgroupRoot   = "/sys/fs/cgroup"
+	cgroupParent = "pipego-runner"
+
 	lineCount = 1000
 	lineSep   = '\n'
 	lineWidth = 500 // BOL appended
 
+	progressCount = 100
+
 	routineNum = -1
 
 	tagBOL = "BOL" // break of line
 	tagEOF = "EOF" // end of file
+
+	signalBase = 128
+
+	terminalRows = 24
+	terminalCols = 80
+	terminalEOT  = 0x04
 )
 
+const (
+	EnvOutput    = "PIPEGO_OUTPUT"
+	EnvWorkspace = "PIPEGO_WORKSPACE"
+)
+
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+)
+
+const (
+	AnsiPreserve = "preserve"
+	AnsiStrip    = "strip"
+)
+
+const (
+	PullAlways       = "Always"
+	PullIfNotPresent = "IfNotPresent"
+	PullNever        = "Never"
+)
+
+const (
+	ReasonExited    = "exited"
+	ReasonSignaled  = "signaled"
+	ReasonOOMKilled = "oomkilled"
+	ReasonCancelled = "cancelled"
+	ReasonTimeout   = "timeout"
+	ReasonError     = "error"
+)
+
+// Grace is the default grace period between SIGTERM and SIGKILL in cancellation and timeout
+const Grace = 10 * time.Second
+
+// ErrCancelled is returned in Init and Run if the task is cancelled before running
+var ErrCancelled = errors.New("task cancelled")
+
 type Task interface {
-	Init(context.Context, int, Language) error
+	Init(context.Context, int, Language, Limit) error
 	Deinit(context.Context) error
-	Run(context.Context, string, []string, []string, string) error
+	Run(context.Context, string, string, []string, []string, string) error
+	Progress(ctx context.Context) <-chan Progress
+	Stdin(ctx context.Context) (io.WriteCloser, error)
+	Terminal(ctx context.Context, rows, cols uint16) error
+	Secret(ctx context.Context, secrets []string)
+	Environ(ctx context.Context, environ []string)
+	Ansi(ctx context.Context, mode string) error
+	Resize(ctx context.Context, rows, cols uint16) error
 	Tail(ctx context.Context) Log
+	Wait(ctx context.Context) Status
+	Output(ctx context.Context) (map[string]string, error)
+	Cancel(ctx context.Context, grace time.Duration) error
 }
 
 type Config struct {
 	Config config.Config
 	Logger hclog.Logger
+	Cache  Cache
 }
 
 type Language struct {
-	Name     string
-	Artifact Artifact
+	Name      string
+	Artifact  Artifact
+	Container Container
 }
 
 type Artifact struct {
-	Image   string
-	User    string
-	Pass    string
-	Cleanup bool
+	Image      string
+	User       string
+	Pass       string
+	Cleanup    bool
+	PullPolicy string
+	Credential string
+}
+
+type Container struct {
+	NanoCPUs int64
+	Memory   int64
+	Network  string
+	User     string
+	ReadOnly bool
+	Env      []string
+	Tmpfs    []Tmpfs
+}
+
+type Tmpfs struct {
+	Target string
+	Size   int64
+}
+
+type Limit struct {
+	Timeout   time.Duration
+	Deadline  time.Time
+	Resources Resources
+}
+
+type Resources struct {
+	MilliCPU int64
+	Memory   int64
+	Pids     int64
+	IOWeight int64
 }
 
 type Log struct {
@@ -72,18 +158,59 @@ type Log struct {
 	Width int
 }
 
+// Line is the message of task, and Pos is the line position in Stream for display. Seq is the sequence
+// number of line in task, which increases monotonically across streams for resume.
 type Line struct {
+	Seq     int64
 	Pos     int64
 	Time    int64
 	Message string
+	Stream  string
+}
+
+type Progress struct {
+	ID      string
+	Status  string
+	Current int64
+	Total   int64
+}
+
+type Status struct {
+	ExitCode   int64
+	Signal     string
+	OOMKilled  bool
+	Reason     string
+	StartTime  int64
+	EndTime    int64
+	Duration   int64
+	MemoryPeak int64
+	CPUTime    int64
+	Outputs    map[string]string
 }
 
 type task struct {
-	cfg     *Config
-	lang    Language
-	log     Log
-	wg      sync.WaitGroup
-	_client *client.Client
+	cfg       *Config
+	lang      Language
+	limit     Limit
+	log       Log
+	status    Status
+	done      chan struct{}
+	wg        sync.WaitGroup
+	mu        sync.Mutex
+	exec      Executor
+	progress  chan Progress
+	started   bool
+	stdin     *os.File
+	tty       bool
+	rows      uint16
+	cols      uint16
+	cancelled bool
+	abort     context.CancelFunc
+	timedOut  bool
+	output    string
+	masker    *strings.Replacer
+	environ   []string
+	strip     bool
 }
 
 func New(_ context.Context, cfg *Config) Task {
@@ -96,9 +223,12 @@ func DefaultConfig() *Config {
 	return &Config{}
 }
 
-func (t *task) Init(ctx context.Context, width int, lang Language) error {
+func (t *task) Init(ctx context.Context, width int, lang Language, limit Limit) error {
 	var w int
 
+	// Progress is closed in all returns, and the receiver never blocks
+	defer t.closeProgress()
+
 	if width > 0 {
 		w = width
 	} else {
@@ -110,247 +240,474 @@ func (t *task) Init(ctx context.Context, width int, lang Language) error {
 		Width: w,
 	}
 
+	t.done = make(chan struct{})
+	t.limit = limit
 	t.lang = lang
-	if t.lang.Name != langBash {
-		t._client, _ = client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
 
-		if err := t.pullImage(ctx, t.lang.Artifact.Image, t.lang.Artifact.User, t.lang.Artifact.Pass); err != nil {
-			return errors.Wrap(err, "failed to pull image")
-		}
+	// Preparing (e.g. image pull) is aborted in Cancel before the task is started
+	t.mu.Lock()
+	if t.cancelled {
+		t.mu.Unlock()
+		return ErrCancelled
 	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	t.abort = cancel
+	t.mu.Unlock()
 
-	return nil
+	e, err := lookup(ctx, t.cfg, t.lang.Name)
+	if err != nil {
+		return t.cancelErr(errors.Wrap(err, "failed to lookup executor"))
+	}
+
+	// Executor is read in Cancel, which may be called while preparing
+	t.mu.Lock()
+	t.exec = e
+	t.mu.Unlock()
+
+	if err := e.Prepare(ctx, t.lang, t.sendProgress); err != nil {
+		return t.cancelErr(errors.Wrap(err, "failed to prepare executor"))
+	}
+
+	return t.cancelErr(nil)
+}
+
+// cancelErr returns ErrCancelled if the task is cancelled, or err otherwise
+func (t *task) cancelErr(err error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.cancelled {
+		return ErrCancelled
+	}
+
+	return err
 }
 
 func (t *task) Deinit(ctx context.Context) error {
-	if t.lang.Name != langBash {
-		if t.lang.Artifact.Cleanup {
-			_ = t.removeImage(ctx, t.lang.Artifact.Image)
-		}
+	t.closeStdin()
 
-		if t._client != nil {
-			_ = t._client.Close()
-		}
+	t.mu.Lock()
+	e := t.exec
+	t.mu.Unlock()
+
+	if e != nil {
+		_ = e.Cleanup(ctx)
 	}
 
 	return nil
 }
 
-func (t *task) Run(ctx context.Context, _ string, env, cmd []string, file string) error {
-	var err error
+func (t *task) Run(ctx context.Context, _, dir string, env, cmd []string, file string) error {
+	// Task is never started if the deadline is exceeded (e.g. in queue), and it ends in timeout
+	timeout, err := t.timeout()
+	if err != nil {
+		t.mu.Lock()
+		t.timedOut = true
+		t.mu.Unlock()
+		go func() {
+			t.routine(ctx, nil, nil)
+			t.setStatus(Status{})
+		}()
+		return nil
+	}
 
-	if t.lang.Name == langBash {
-		err = t.runBash(ctx, env, cmd, file)
-	} else {
-		err = t.runLanguage(ctx, env, file)
+	spec := Spec{
+		Dir:       dir,
+		Env:       env,
+		Cmd:       cmd,
+		File:      file,
+		Resources: t.limit.Resources,
 	}
 
-	if err != nil {
+	if dir != "" {
+		spec.Output = filepath.Join(dir, outputName)
+	}
+
+	t.mu.Lock()
+	if t.cancelled {
+		t.mu.Unlock()
+		return ErrCancelled
+	}
+	spec.Stdin = t.stdin
+	spec.Environ = t.environ
+	spec.Tty, spec.Rows, spec.Cols = t.tty, t.rows, t.cols
+	if err := t.exec.Start(ctx, spec); err != nil {
+		t.mu.Unlock()
 		return errors.Wrap(err, "failed to run task")
 	}
+	t.started = true
+	t.output = spec.Output
+	t.mu.Unlock()
+
+	stdout, stderr := t.exec.Stream(ctx)
+	expire := t.expire(ctx, timeout)
+
+	// Output is streamed while running, and the status is collected as the output is drained
+	go func() {
+		t.routine(ctx, t.reader(stdout), t.reader(stderr))
+		status := t.exec.Wait(ctx)
+		expire.Stop()
+		t.closeStdin()
+		t.setStatus(status)
+	}()
 
 	return nil
 }
 
-func (t *task) Tail(_ context.Context) Log {
-	return t.log
+// Progress returns the progress of Init, which must be called before Init and is closed as Init returns.
+// The progress is discarded if Progress is not called.
+func (t *task) Progress(_ context.Context) <-chan Progress {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.progress == nil {
+		t.progress = make(chan Progress, progressCount)
+	}
+
+	return t.progress
 }
 
-// nolint:ineffassign
-func (t *task) runBash(ctx context.Context, env, cmd []string, file string) error {
-	var name string
-	var arg []string
-	var err error
+func (t *task) sendProgress(progress Progress) {
+	t.mu.Lock()
+	p := t.progress
+	t.mu.Unlock()
 
-	name, err = exec.LookPath(langBash)
-	if err != nil {
-		return errors.New("name not found")
+	if p != nil {
+		p <- progress
 	}
+}
 
-	if len(cmd) != 0 {
-		arg = []string{"-c", strings.Join(cmd, " ")}
-	} else {
-		if file != "" {
-			arg = []string{"-c", file}
-		} else {
-			return errors.New("invalid file")
-		}
+func (t *task) closeProgress() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.progress != nil {
+		close(t.progress)
 	}
+}
 
-	c := exec.CommandContext(ctx, name, arg...)
-	c.Env = append(c.Environ(), env...)
+// Stdin returns the writer connected to stdin of bash or container, which must be called before Run.
+// Stdin is closed as the writer is closed, and it is discarded if Stdin is not called.
+func (t *task) Stdin(_ context.Context) (io.WriteCloser, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	stdoutPipe, _ := c.StdoutPipe()
-	stdoutReader := bufio.NewReader(stdoutPipe)
+	if t.stdin != nil {
+		return nil, errors.New("stdin already opened")
+	}
 
-	stderrPipe, _ := c.StderrPipe()
-	stderrReader := bufio.NewReader(stderrPipe)
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to pipe")
+	}
 
-	_ = c.Start()
-	t.routine(ctx, stdoutReader, stderrReader)
+	t.stdin = r
 
-	g, _ := errgroup.WithContext(ctx)
-	g.SetLimit(routineNum)
+	return w, nil
+}
 
-	g.Go(func() error {
-		_ = c.Wait()
-		return nil
-	})
+func (t *task) closeStdin() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	if err = g.Wait(); err != nil {
-		return errors.Wrap(err, "failed to wait")
+	if t.stdin != nil {
+		_ = t.stdin.Close()
+		t.stdin = nil
 	}
-
-	return nil
 }
 
-func (t *task) runLanguage(ctx context.Context, env []string, file string) error {
-	name := []string{filepath.Join(string(os.PathSeparator), langTarget, filepath.Base(file))}
-	source := filepath.Dir(file)
+// Terminal allocates pseudo-terminal for bash and container in rows/cols, which must be called before Run.
+// Stdout and stderr are merged into stdout of terminal, and stdin is written into terminal if opened.
+func (t *task) Terminal(_ context.Context, rows, cols uint16) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	id, stdout, err := t.runContainer(ctx, t.lang.Artifact.Image, env, name, source, langTarget)
-	if err != nil {
-		return errors.Wrap(err, "failed to run container")
+	t.tty = true
+	t.rows, t.cols = rows, cols
+
+	if t.rows == 0 || t.cols == 0 {
+		t.rows, t.cols = terminalRows, terminalCols
 	}
 
-	t.routine(ctx, stdout, nil)
+	return nil
+}
 
-	_ = t.removeContainer(ctx, id)
+// Secret masks the secrets in the output and outputs of task, which must be called before Run
+func (t *task) Secret(_ context.Context, secrets []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	return nil
+	t.masker = newMasker(secrets)
 }
 
-func (t *task) pullImage(ctx context.Context, name, user, pass string) error {
-	_config := registry.AuthConfig{
-		Username: user,
-		Password: pass,
+// Environ sets the variables of runner passed to task, which must be called before Run.
+// The default of executor is used if Environ is not called.
+func (t *task) Environ(_ context.Context, environ []string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.environ = environ
+}
+
+// Ansi sets the mode of escape sequences in output (default: preserve), which must be called before Run
+func (t *task) Ansi(_ context.Context, mode string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch mode {
+	case "", AnsiPreserve:
+		t.strip = false
+	case AnsiStrip:
+		t.strip = true
+	default:
+		return errors.New("invalid ansi")
 	}
 
-	encodedJSON, _ := json.Marshal(_config)
-	auth := base64.URLEncoding.EncodeToString(encodedJSON)
+	return nil
+}
 
-	options := image.PullOptions{}
+func (t *task) Resize(ctx context.Context, rows, cols uint16) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-	if user != "" && pass != "" {
-		options.RegistryAuth = auth
+	if !t.tty {
+		return errors.New("terminal not allocated")
 	}
 
-	out, err := t._client.ImagePull(ctx, name, options)
-	if err != nil {
-		return errors.Wrap(err, "failed to pull image")
+	if rows == 0 || cols == 0 {
+		return errors.New("invalid size")
 	}
 
-	defer func(out io.ReadCloser) {
-		_ = out.Close()
-	}(out)
+	t.rows, t.cols = rows, cols
 
-	_, _ = io.Copy(os.Stdout, out)
+	if t.started {
+		if err := t.exec.Resize(ctx, rows, cols); err != nil {
+			return errors.Wrap(err, "failed to resize")
+		}
+	}
 
 	return nil
 }
 
-func (t *task) runContainer(ctx context.Context, name string, env, cmd []string, source, target string) (id string,
-	stdout *bufio.Reader, err error) {
-	_config := &container.Config{
-		Image: name,
-		Env:   env,
-		Cmd:   cmd,
-		Tty:   false,
+func (t *task) Tail(_ context.Context) Log {
+	return t.log
+}
+
+func (t *task) Wait(ctx context.Context) Status {
+	select {
+	case <-ctx.Done():
+		return Status{}
+	case <-t.done:
+		return t.status
 	}
+}
+
+// Output returns the outputs written by task in the file of EnvOutput, which must be called after Wait
+func (t *task) Output(_ context.Context) (map[string]string, error) {
+	t.mu.Lock()
+	name, masker := t.output, t.masker
+	t.mu.Unlock()
 
-	hostConfig := &container.HostConfig{
-		Mounts: []mount.Mount{
-			{
-				Type:   mount.TypeBind,
-				Source: source,
-				Target: target,
-			},
-		},
+	if name == "" {
+		return nil, nil
 	}
 
-	resp, err := t._client.ContainerCreate(ctx, _config, hostConfig, &network.NetworkingConfig{},
-		nil, "")
+	outputs, err := readOutput(name)
 	if err != nil {
-		return "", nil, errors.Wrap(err, "failed to create container")
+		return nil, err
 	}
 
-	if err = t._client.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
-		return "", nil, errors.Wrap(err, "failed to start container")
+	if masker != nil {
+		for key, val := range outputs {
+			outputs[key] = masker.Replace(val)
+		}
 	}
 
-	statusCh, errCh := t._client.ContainerWait(ctx, resp.ID, container.WaitConditionNotRunning)
-	select {
-	case err := <-errCh:
-		if err != nil {
-			return "", nil, errors.Wrap(err, "failed to wait container")
+	return outputs, nil
+}
+
+func (t *task) Cancel(ctx context.Context, grace time.Duration) error {
+	t.mu.Lock()
+	t.cancelled = true
+	if !t.started && t.abort != nil {
+		t.abort()
+	}
+	t.mu.Unlock()
+
+	return t.stop(ctx, grace)
+}
+
+// stop stops the process of task with grace in executor
+func (t *task) stop(ctx context.Context, grace time.Duration) error {
+	t.mu.Lock()
+	e := t.exec
+	t.mu.Unlock()
+
+	if e == nil {
+		return nil
+	}
+
+	if err := e.Kill(ctx, grace); err != nil {
+		return errors.Wrap(err, "failed to kill")
+	}
+
+	return nil
+}
+
+// timeout returns the time left to run in limit, or an error if the deadline is exceeded
+func (t *task) timeout() (time.Duration, error) {
+	timeout := t.limit.Timeout
+
+	if !t.limit.Deadline.IsZero() {
+		d := time.Until(t.limit.Deadline)
+		if d <= 0 {
+			return 0, errors.New("deadline exceeded")
+		}
+		if timeout <= 0 || d < timeout {
+			timeout = d
 		}
-	case <-statusCh:
 	}
 
-	reader, err := t._client.ContainerLogs(ctx, resp.ID, container.LogsOptions{ShowStdout: true, ShowStderr: true})
-	if err != nil {
-		return "", nil, errors.Wrap(err, "failed to log container")
+	return timeout, nil
+}
+
+// expire stops the task with the grace in config as the timeout elapses, which never fires without timeout
+func (t *task) expire(ctx context.Context, timeout time.Duration) *time.Timer {
+	if timeout <= 0 {
+		timeout = math.MaxInt64
 	}
 
-	stdout = bufio.NewReader(reader)
+	return time.AfterFunc(timeout, func() {
+		t.mu.Lock()
+		t.timedOut = true
+		t.mu.Unlock()
+		t.cfg.Logger.Debug("expire: timeout", timeout)
+		_ = t.stop(ctx, t.grace())
+	})
+}
 
-	return resp.ID, stdout, nil
+// grace returns the grace period in config, or the default one if not set
+func (t *task) grace() time.Duration {
+	if t.cfg.Config.Spec.Task.Grace > 0 {
+		return t.cfg.Config.Spec.Task.Grace
+	}
+
+	return Grace
 }
 
-func (t *task) removeContainer(ctx context.Context, id string) error {
-	options := container.RemoveOptions{
-		RemoveVolumes: true,
-		RemoveLinks:   true,
-		Force:         true,
+func (t *task) setStatus(status Status) {
+	t.mu.Lock()
+	if t.timedOut {
+		status.Reason = ReasonTimeout
+	} else if t.cancelled {
+		status.Reason = ReasonCancelled
 	}
+	t.mu.Unlock()
+
+	t.status = status
+	close(t.done)
+}
 
-	defer func(ctx context.Context, c *client.Client) {
-		_, _ = c.ContainersPrune(ctx, filters.Args{})
-	}(ctx, t._client)
+func (t *task) reader(r io.Reader) *bufio.Reader {
+	if r == nil {
+		return nil
+	}
 
-	_ = t._client.ContainerRemove(ctx, id, options)
+	if b, ok := r.(*bufio.Reader); ok {
+		return b
+	}
 
-	return nil
+	return bufio.NewReader(r)
 }
 
-func (t *task) removeImage(ctx context.Context, id string) error {
-	options := image.RemoveOptions{
-		Force:         true,
-		PruneChildren: true,
+// readLine reads a line from reader, and the partial line is returned with error at the end. The pending partial
+// line (e.g. prompt) is also returned in interactive mode as no more data is buffered, unless a rune is incomplete.
+func (t *task) readLine(reader *bufio.Reader, interactive bool) ([]byte, error) {
+	if !interactive {
+		return reader.ReadBytes(lineSep)
+	}
+
+	var buf []byte
+
+	for {
+		if reader.Buffered() == 0 {
+			if len(buf) != 0 && t.fullRune(buf) {
+				return buf, nil
+			}
+			if _, err := reader.Peek(1); err != nil {
+				return buf, err
+			}
+		}
+		b, _ := reader.Peek(reader.Buffered())
+		if i := bytes.IndexByte(b, lineSep); i >= 0 {
+			buf = append(buf, b[:i+1]...)
+			_, _ = reader.Discard(i + 1)
+			return buf, nil
+		}
+		buf = append(buf, b...)
+		_, _ = reader.Discard(len(b))
 	}
+}
 
-	defer func(ctx context.Context, c *client.Client) {
-		_, _ = c.ImagesPrune(ctx, filters.Args{})
-	}(ctx, t._client)
+// fullRune reports whether buf ends with a complete rune
+func (t *task) fullRune(buf []byte) bool {
+	i := len(buf) - 1
 
-	_, _ = t._client.ImageRemove(ctx, id, options)
+	for i > 0 && i > len(buf)-utf8.UTFMax && !utf8.RuneStart(buf[i]) {
+		i--
+	}
 
-	return nil
+	return utf8.FullRune(buf[i:])
 }
 
 func (t *task) routine(ctx context.Context, stdout, stderr *bufio.Reader) {
+	var n atomic.Int64
+	var mu sync.Mutex
+	var seq int64
+
 	w := t.log.Width - utf8.RuneCountInString(tagBOL)
-	p := 1
 
-	helper := func(_ context.Context, reader *bufio.Reader, log Log) {
+	t.mu.Lock()
+	masker, strip := t.masker, t.strip
+	interactive := t.stdin != nil || t.tty
+	t.mu.Unlock()
+
+	// Lines are sent in order of sequence number across streams
+	send := func(line *Line) {
+		mu.Lock()
+		defer mu.Unlock()
+		seq += 1
+		line.Seq = seq
+		t.log.Line.In <- line
+	}
+
+	helper := func(_ context.Context, reader *bufio.Reader, stream string) {
 		defer t.wg.Done()
 		if reader == nil {
 			return
 		}
+		p := 1
 		for {
-			line, err := reader.ReadBytes(lineSep)
+			// Partial line is sent at EOF (or EIO of terminal), and the rest of it shares the same position
+			line, err := t.readLine(reader, interactive)
+			if len(line) != 0 {
+				// Secrets are masked before line is split in width
+				s := string(line)
+				if masker != nil {
+					s = masker.Replace(s)
+				}
+				for _, item := range splitLine(s, w, strip) {
+					send(&Line{Pos: int64(p), Time: time.Now().UnixNano(), Message: item, Stream: stream})
+				}
+				if line[len(line)-1] == lineSep || err != nil {
+					p += 1
+					n.Add(1)
+				}
+			}
 			if err != nil {
 				break
 			}
-			s := string(line)
-			r := utf8.RuneCountInString(s) / w
-			m := utf8.RuneCountInString(s) % w
-			b := []rune(s)
-			for i := 0; i < r; i++ {
-				log.Line.In <- &Line{Pos: int64(p), Time: time.Now().UnixNano(), Message: string(b[i*w:(i+1)*w]) + tagBOL}
-			}
-			log.Line.In <- &Line{Pos: int64(p), Time: time.Now().UnixNano(), Message: string(b[len(b)-m:])}
-			p += 1
 		}
 	}
 
@@ -359,20 +716,20 @@ func (t *task) routine(ctx context.Context, stdout, stderr *bufio.Reader) {
 
 	t.wg.Add(1)
 	g.Go(func() error {
-		helper(ctx, stdout, t.log)
+		helper(ctx, stdout, StreamStdout)
 		return nil
 	})
 
 	t.wg.Add(1)
 	g.Go(func() error {
-		helper(ctx, stderr, t.log)
+		helper(ctx, stderr, StreamStderr)
 		return nil
 	})
 
 	g.Go(func() error {
 		t.wg.Wait()
 		t.cfg.Logger.Debug("routine: Message: tagEOF")
-		t.log.Line.In <- &Line{Pos: int64(p), Time: time.Now().UnixNano(), Message: tagEOF}
+		send(&Line{Pos: n.Load() + 1, Time: time.Now().UnixNano(), Message: tagEOF})
 		close(t.log.Line.In)
 		return nil
 	})
@@ -5,30 +5,17 @@ package task
 import (
 	"context"
 	"fmt"
-	"os"
+	"io"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 	"unicode/utf8"
 
-	"github.com/docker/docker/client"
-	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
-
-	"github.com/pipego/runner/config"
 )
 
 var (
-	testBash = Language{
-		Name: langBash,
-		Artifact: Artifact{
-			Image:   "",
-			User:    "",
-			Pass:    "",
-			Cleanup: false,
-		},
-	}
-
 	testGroovy = Language{
 		Name: "groovy",
 		Artifact: Artifact{
@@ -40,21 +27,6 @@ var (
 	}
 )
 
-func initTask() *task {
-	t := task{
-		cfg: DefaultConfig(),
-		log: Log{},
-	}
-
-	t.cfg.Config = config.Config{}
-	t.cfg.Logger = hclog.New(&hclog.LoggerOptions{
-		Name:  "task",
-		Level: hclog.LevelFromString("DEBUG"),
-	})
-
-	return &t
-}
-
 func TestRunEcho(t *testing.T) {
 	var env []string
 	var cmd []string
@@ -64,15 +36,15 @@ func TestRunEcho(t *testing.T) {
 	_t := initTask()
 	ctx := context.Background()
 
-	err = _t.Init(ctx, lineWidth, testBash)
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
 	assert.Equal(t, nil, err)
 
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.NotEqual(t, nil, err)
 
 	env = []string{"ENV1=task1", "ENV2=task2"}
 	cmd = []string{"echo $ENV1 $ENV2"}
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.Equal(t, nil, err)
 
 	log := _t.Tail(ctx)
@@ -103,14 +75,14 @@ func TestRunBash(t *testing.T) {
 	_t := initTask()
 	ctx := context.Background()
 
-	err = _t.Init(ctx, lineWidth, testBash)
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
 	assert.Equal(t, nil, err)
 
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.NotEqual(t, nil, err)
 
 	cmd = []string{"../test/bash.sh"}
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.Equal(t, nil, err)
 
 	log := _t.Tail(ctx)
@@ -141,12 +113,12 @@ func TestRunGroovy(t *testing.T) {
 	_t := initTask()
 	ctx := context.Background()
 
-	err = _t.Init(ctx, lineWidth, testGroovy)
+	err = _t.Init(ctx, lineWidth, testGroovy, Limit{})
 	assert.Equal(t, nil, err)
 
 	env = []string{"ENV1=task1", "ENV2=task2"}
 	file, _ = filepath.Abs("../test/jenkinsfile")
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.Equal(t, nil, err)
 
 	log := _t.Tail(ctx)
@@ -177,14 +149,14 @@ func TestRunPython(t *testing.T) {
 	_t := initTask()
 	ctx := context.Background()
 
-	err = _t.Init(ctx, lineWidth, testBash)
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
 	assert.Equal(t, nil, err)
 
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.NotEqual(t, nil, err)
 
 	cmd = []string{"python3 ../test/python.py"}
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.Equal(t, nil, err)
 
 	log := _t.Tail(ctx)
@@ -215,14 +187,14 @@ func TestRunSplit(t *testing.T) {
 	_t := initTask()
 	ctx := context.Background()
 
-	err = _t.Init(ctx, lineWidth, testBash)
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
 	assert.Equal(t, nil, err)
 
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.NotEqual(t, nil, err)
 
 	cmd = []string{"python3 ../test/split.py"}
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.Equal(t, nil, err)
 
 	log := _t.Tail(ctx)
@@ -234,8 +206,14 @@ L:
 			if line.Message == tagEOF {
 				break L
 			}
+			// Wide rune is never split, and the width of message is filled except one column at most
 			if strings.HasSuffix(line.Message, tagBOL) {
-				assert.Equal(t, lineWidth, utf8.RuneCountInString(line.Message))
+				n := 0
+				for _, r := range strings.TrimSuffix(line.Message, tagBOL) {
+					n += runeWidth(r)
+				}
+				assert.LessOrEqual(t, n, lineWidth-utf8.RuneCountInString(tagBOL))
+				assert.Greater(t, n, lineWidth-utf8.RuneCountInString(tagBOL)-2)
 			}
 		}
 	}
@@ -253,14 +231,14 @@ func TestRunError(t *testing.T) {
 	_t := initTask()
 	ctx := context.Background()
 
-	err = _t.Init(ctx, lineWidth, testBash)
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
 	assert.Equal(t, nil, err)
 
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.NotEqual(t, nil, err)
 
 	cmd = []string{"../test/error.sh"}
-	err = _t.Run(ctx, "", env, cmd, file)
+	err = _t.Run(ctx, "", "", env, cmd, file)
 	assert.Equal(t, nil, err)
 
 	log := _t.Tail(ctx)
@@ -283,29 +261,64 @@ L:
 }
 
 func TestImageContainer(t *testing.T) {
-	_t := initTask()
-	_t._client, _ = client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
-
-	defer func(_client *client.Client) {
-		_ = _client.Close()
-	}(_t._client)
+	d := &docker{
+		cfg: DefaultConfig(),
+	}
 
 	ctx := context.Background()
 
-	err := _t.pullImage(ctx, testGroovy.Artifact.Image, testGroovy.Artifact.User, testGroovy.Artifact.Pass)
+	lang := testGroovy
+	lang.Artifact.Cleanup = true
+
+	err := d.Prepare(ctx, lang, func(Progress) {})
 	assert.Equal(t, nil, err)
 
 	env := []string{"ENV1=task1", "ENV2=task2"}
-	cmd := []string{filepath.Join(string(os.PathSeparator), langTarget, "jenkinsfile")}
-	source, _ := filepath.Abs("../test")
-	target := langTarget
+	file, _ := filepath.Abs("../test/jenkinsfile")
 
-	id, _, err := _t.runContainer(ctx, testGroovy.Artifact.Image, env, cmd, source, target)
+	err = d.Start(ctx, Spec{Env: env, File: file})
 	assert.Equal(t, nil, err)
 
-	err = _t.removeContainer(ctx, id)
+	stdout, stderr := d.Stream(ctx)
+	_, _ = io.Copy(io.Discard, io.MultiReader(stdout, stderr))
+
+	status := d.Wait(ctx)
+	assert.Equal(t, ReasonExited, status.Reason)
+
+	err = d.Cleanup(ctx)
 	assert.Equal(t, nil, err)
+}
+
+func TestRunLive(t *testing.T) {
+	var env []string
+	var cmd []string
+	var file string
+	var err error
+
+	_t := initTask()
+	ctx := context.Background()
+
+	err = _t.Init(ctx, lineWidth, testBash, Limit{})
+	assert.Equal(t, nil, err)
+
+	cmd = []string{"echo live; sleep 2"}
+	err = _t.Run(ctx, "", "", env, cmd, file)
+	assert.Equal(t, nil, err)
+
+	log := _t.Tail(ctx)
 
-	err = _t.removeImage(ctx, testGroovy.Artifact.Image)
+	line := <-log.Line.Out
+	assert.Equal(t, "live\n", line.Message)
+
+	c, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+
+	status := _t.Wait(c)
+	assert.Equal(t, "", status.Reason)
+
+	status = _t.Wait(ctx)
+	assert.Equal(t, ReasonExited, status.Reason)
+
+	err = _t.Deinit(ctx)
 	assert.Equal(t, nil, err)
 }
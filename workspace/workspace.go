@@ -0,0 +1,140 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/pipego/runner/config"
+)
+
+const (
+	Perm   = 0755
+	Prefix = "pipego-task-"
+	Root   = "pipego-runner"
+)
+
+type Workspace interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Create(context.Context, string) (string, error)
+	Release(context.Context, string, bool) error
+	Purge(context.Context) error
+}
+
+type Config struct {
+	Config config.Config
+	Logger hclog.Logger
+}
+
+type workspace struct {
+	cfg      *Config
+	mu       sync.Mutex
+	retained map[string]time.Time
+}
+
+func New(_ context.Context, cfg *Config) Workspace {
+	return &workspace{
+		cfg:      cfg,
+		retained: map[string]time.Time{},
+	}
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Init creates root, and removes the workspaces left by the previous runner if they are expired
+func (w *workspace) Init(_ context.Context) error {
+	root := w.root()
+
+	if err := os.MkdirAll(root, Perm); err != nil {
+		return errors.Wrap(err, "failed to make root")
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return errors.Wrap(err, "failed to read root")
+	}
+
+	for _, item := range entries {
+		if !item.IsDir() || !strings.HasPrefix(item.Name(), Prefix) {
+			continue
+		}
+		info, err := item.Info()
+		if err != nil {
+			continue
+		}
+		if time.Since(info.ModTime()) >= w.cfg.Config.Spec.Workspace.Retention {
+			_ = os.RemoveAll(filepath.Join(root, item.Name()))
+		}
+	}
+
+	return nil
+}
+
+func (w *workspace) Deinit(_ context.Context) error {
+	return nil
+}
+
+// Create makes the unique workspace of task id in root
+func (w *workspace) Create(ctx context.Context, id string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\`) || id == "." || id == ".." {
+		return "", errors.New("invalid id")
+	}
+
+	_ = w.Purge(ctx)
+
+	path := filepath.Join(w.root(), Prefix+id)
+
+	if err := os.Mkdir(path, Perm); err != nil {
+		return "", errors.Wrap(err, "failed to make workspace")
+	}
+
+	return path, nil
+}
+
+// Release removes the workspace, or retains it until Purge if the task is failed and retention is enabled
+func (w *workspace) Release(_ context.Context, path string, failed bool) error {
+	if failed && w.cfg.Config.Spec.Workspace.Retention > 0 {
+		w.mu.Lock()
+		w.retained[path] = time.Now()
+		w.mu.Unlock()
+		return nil
+	}
+
+	if err := os.RemoveAll(path); err != nil {
+		return errors.Wrap(err, "failed to remove workspace")
+	}
+
+	return nil
+}
+
+// Purge removes the retained workspaces which are expired
+func (w *workspace) Purge(_ context.Context) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for path, t := range w.retained {
+		if time.Since(t) >= w.cfg.Config.Spec.Workspace.Retention {
+			_ = os.RemoveAll(path)
+			delete(w.retained, path)
+		}
+	}
+
+	return nil
+}
+
+func (w *workspace) root() string {
+	if w.cfg.Config.Spec.Workspace.Root != "" {
+		return w.cfg.Config.Spec.Workspace.Root
+	}
+
+	return filepath.Join(os.TempDir(), Root)
+}
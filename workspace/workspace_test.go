@@ -0,0 +1,119 @@
+package workspace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+)
+
+func initWorkspace(t *testing.T, retention time.Duration) *workspace {
+	w := workspace{
+		cfg:      DefaultConfig(),
+		retained: map[string]time.Time{},
+	}
+
+	w.cfg.Config.Spec.Workspace.Root = t.TempDir()
+	w.cfg.Config.Spec.Workspace.Retention = retention
+	w.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "workspace",
+		Level: hclog.LevelFromString("DEBUG"),
+	})
+
+	return &w
+}
+
+func TestInit(t *testing.T) {
+	w := initWorkspace(t, time.Hour)
+	ctx := context.Background()
+
+	root := w.cfg.Config.Spec.Workspace.Root
+	expired := filepath.Join(root, Prefix+"expired")
+	retained := filepath.Join(root, Prefix+"retained")
+	other := filepath.Join(root, "other")
+
+	_ = os.Mkdir(expired, Perm)
+	_ = os.Mkdir(retained, Perm)
+	_ = os.Mkdir(other, Perm)
+	_ = os.Chtimes(expired, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+	_ = os.Chtimes(other, time.Now().Add(-2*time.Hour), time.Now().Add(-2*time.Hour))
+
+	err := w.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(expired)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	_, err = os.Stat(retained)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(other)
+	assert.Equal(t, nil, err)
+
+	err = w.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestCreate(t *testing.T) {
+	w := initWorkspace(t, 0)
+	ctx := context.Background()
+
+	path, err := w.Create(ctx, "id")
+	assert.Equal(t, nil, err)
+	assert.Equal(t, filepath.Join(w.cfg.Config.Spec.Workspace.Root, Prefix+"id"), path)
+
+	_, err = w.Create(ctx, "id")
+	assert.NotEqual(t, nil, err)
+
+	_, err = w.Create(ctx, "")
+	assert.NotEqual(t, nil, err)
+
+	_, err = w.Create(ctx, "../id")
+	assert.NotEqual(t, nil, err)
+}
+
+func TestRelease(t *testing.T) {
+	w := initWorkspace(t, 0)
+	ctx := context.Background()
+
+	path, _ := w.Create(ctx, "succeeded")
+	err := w.Release(ctx, path, false)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	path, _ = w.Create(ctx, "failed")
+	err = w.Release(ctx, path, true)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+}
+
+func TestPurge(t *testing.T) {
+	w := initWorkspace(t, time.Hour)
+	ctx := context.Background()
+
+	path, _ := w.Create(ctx, "failed")
+	err := w.Release(ctx, path, true)
+	assert.Equal(t, nil, err)
+
+	err = w.Purge(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(path)
+	assert.Equal(t, nil, err)
+
+	w.retained[path] = time.Now().Add(-2 * time.Hour)
+
+	err = w.Purge(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(path)
+	assert.Equal(t, true, os.IsNotExist(err))
+}